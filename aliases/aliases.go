@@ -7,9 +7,11 @@ const (
 	AuthAlias         = "auth"
 	AuthenticateAlias = "authenticate"
 	RegisterAlias     = "reg"
+	LogoutAlias       = "signout"
 	SignupAlias       = "signup"
 	NodeAlias         = "node"
 	NodAlias          = "nod"
+	GetNodesAlias     = "nodes"
 	NodesAlias        = "nodess"
 	PoliciesAlias     = "policie"
 	PoliciesAliasAlt  = "policiess"
@@ -26,6 +28,7 @@ const (
 	GrpAlias          = "grp"
 	GrAlias           = "gr"
 	LabelAlias        = "l"
+	LabelsLabelAlias  = "label"
 	LabAlias          = "lab"
 	LblAlias          = "lbl"
 	ConfigAlias       = "conf"
@@ -49,18 +52,22 @@ const (
 	PlaceAlias        = "plc"
 	ValidateAlias     = "val"
 	CompareAlias      = "compare"
+	DescribeAlias     = "desc"
+	HistoryAlias      = "hist"
 )
 
 // Specific command aliases
 var (
 	LoginAliases      = []string{LoginAlias, SigninAlias, AuthAlias, AuthenticateAlias}
 	RegisterAliases   = []string{RegisterAlias, SignupAlias}
+	LogoutAliases     = []string{LogoutAlias}
 	NodesAliases      = []string{NodeAlias, NodAlias, NodesAlias}
 	PoliciesAliases   = []string{PoliciesAlias, PoliciesAliasAlt, PolAlias}
 	RelationsAliases  = []string{RelationsAlias, RelationAlias, RelAlias, RelateAlias}
 	SchemaAliases     = []string{SchemaAlias, SchemaAliasAlt, ScheAlias}
 	GroupAliases      = []string{GroupAlias, GrouAlias, GrpAlias, GrAlias}
 	LabelAliases      = []string{LabelAlias, LabAlias, LblAlias}
+	LabelsAliases     = []string{LabelsLabelAlias, LabelAlias, LabAlias, LblAlias}
 	ConfigAliases     = []string{ConfigAlias, CnfgAlias, CfgAlias, ConAlias}
 	StandaloneAliases = []string{StandaloneAlias}
 	VersionAliases    = []string{VersionAlias, VersionAliasAlt}
@@ -75,4 +82,7 @@ var (
 	PlaceAliases      = []string{PlaceAlias}
 	ValidateAliases   = []string{ValidateAlias}
 	CompareAliases    = []string{CompareAlias}
+	DescribeAliases   = []string{DescribeAlias}
+	HistoryAliases    = []string{HistoryAlias}
+	GetNodeAliases    = []string{GetNodesAlias, NodAlias}
 )
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func (c *Client) RegisterUser(ctx context.Context, details model.RegistrationDetails) error {
+	return c.call(ctx, "RegisterUser", "POST", false, details, nil)
+}
+
+func (c *Client) LoginUser(ctx context.Context, credentials model.Credentials) (model.TokenResponse, error) {
+	var response model.TokenResponse
+	err := c.call(ctx, "LoginUser", "POST", false, credentials, &response)
+	return response, err
+}
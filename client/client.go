@@ -0,0 +1,244 @@
+// Package client is a typed Go client for the c12s gateway. It resolves
+// gateway actions through the route configuration used by the gateway itself
+// and exposes one method per action.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/c12s/cockpit/config"
+	"github.com/c12s/cockpit/model"
+)
+
+const (
+	defaultGroup   = "core"
+	defaultVersion = "v1"
+	defaultTimeout = 10 * time.Second
+)
+
+// TokenSource supplies the bearer token for authenticated actions.
+type TokenSource func() (string, error)
+
+// Reauthenticator is asked for a fresh token after the gateway rejects a
+// request with 401; the request is then retried once with that token.
+type Reauthenticator func(ctx context.Context) (string, error)
+
+type Client struct {
+	gateway    string
+	route      string
+	routes     *config.Config
+	metricsURL string
+	httpClient *http.Client
+	timeout    time.Duration
+	token      TokenSource
+	reauth     Reauthenticator
+	retry      RetryPolicy
+	headers    map[string]string
+	traceOut   io.Writer
+	traceLevel int
+	dryRun     DryRunHandler
+}
+
+type Option func(*Client)
+
+// New creates a client for the gateway at address (scheme://host:port) whose
+// actions are described by routes.
+func New(address string, routes *config.Config, opts ...Option) *Client {
+	c := &Client{
+		gateway:    address,
+		route:      routes.Gateway.Route,
+		routes:     routes,
+		metricsURL: defaultMetricsURL,
+		httpClient: &http.Client{},
+		timeout:    defaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func WithTokenSource(source TokenSource) Option {
+	return func(c *Client) { c.token = source }
+}
+
+func WithReauthenticator(reauth Reauthenticator) Option {
+	return func(c *Client) { c.reauth = reauth }
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) { c.timeout = timeout }
+}
+
+// WithRoute overrides the route prefix taken from the route configuration.
+func WithRoute(route string) Option {
+	return func(c *Client) {
+		if route != "" {
+			c.route = route
+		}
+	}
+}
+
+func WithMetricsURL(metricsURL string) Option {
+	return func(c *Client) { c.metricsURL = metricsURL }
+}
+
+// WithHeaders adds headers to every request, overriding the defaults.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = map[string]string{}
+		}
+		for key, value := range headers {
+			c.headers[key] = value
+		}
+	}
+}
+
+// URL returns the full gateway URL of action.
+func (c *Client) URL(action string) (string, error) {
+	methodConfig, ok := c.routes.Groups[defaultGroup][defaultVersion][action]
+	if !ok {
+		return "", fmt.Errorf("configuration for %s/%s/%s not found", defaultGroup, defaultVersion, action)
+	}
+	return fmt.Sprintf("%s%s/%s/%s%s", c.gateway, c.route, defaultGroup, defaultVersion, methodConfig.MethodRoute), nil
+}
+
+// call sends body to a gateway action and decodes the answer into out.
+func (c *Client) call(ctx context.Context, action, method string, authenticated bool, body, out interface{}) error {
+	url, err := c.URL(action)
+	if err != nil {
+		return err
+	}
+
+	return c.execute(ctx, action, authenticated, model.HTTPRequestConfig{
+		URL:         url,
+		Method:      method,
+		Headers:     c.headers,
+		RequestBody: body,
+		Response:    out,
+		Timeout:     c.timeout,
+	})
+}
+
+// execute sends request, or hands it to the dry run handler when one is set
+// and the request would change the system.
+func (c *Client) execute(ctx context.Context, action string, authenticated bool, request model.HTTPRequestConfig) error {
+	if c.dryRun != nil && request.Method != http.MethodGet {
+		return c.handleDryRun(action, authenticated, request)
+	}
+
+	if authenticated {
+		token, err := c.bearerToken()
+		if err != nil {
+			return err
+		}
+		request.Token = token
+	}
+
+	return c.send(ctx, request)
+}
+
+func (c *Client) bearerToken() (string, error) {
+	if c.token == nil {
+		return "", fmt.Errorf("%w: no token source is configured", ErrUnauthenticated)
+	}
+	token, err := c.token()
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+	}
+	return token, nil
+}
+
+func (c *Client) send(ctx context.Context, request model.HTTPRequestConfig) error {
+	resp, bodyBytes, err := c.doWithRetry(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && request.Token != "" && c.reauth != nil {
+		request.Token, err = c.reauth(ctx)
+		if err != nil {
+			return fmt.Errorf("%w: request unauthorized and re-login failed: %v", ErrUnauthenticated, err)
+		}
+		resp, bodyBytes, err = c.doWithRetry(ctx, request)
+		if err != nil {
+			return err
+		}
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && request.Token != "" {
+		return fmt.Errorf("the gateway rejected the token, run 'cockpit login': %w", newAPIError(resp.StatusCode, resp.Header, bodyBytes))
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return newAPIError(resp.StatusCode, resp.Header, bodyBytes)
+	}
+
+	if request.Response != nil {
+		if err := json.Unmarshal(bodyBytes, request.Response); err != nil {
+			return fmt.Errorf("failed to decode response: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, request model.HTTPRequestConfig) (*http.Response, []byte, error) {
+	var requestBody []byte
+	var err error
+	if request.RequestBody != nil {
+		requestBody, err = json.Marshal(request.RequestBody)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal request body: %v", err)
+		}
+	}
+
+	if request.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, request.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, request.Method, request.URL, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if request.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+request.Token)
+	}
+	for key, value := range request.Headers {
+		req.Header.Set(key, value)
+	}
+
+	c.traceRequest(req, requestBody)
+	start := time.Now()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.traceError(err, time.Since(start))
+		return nil, nil, &TransportError{Err: err}
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.traceError(err, time.Since(start))
+		return nil, nil, &TransportError{Err: fmt.Errorf("failed to read response body: %v", err)}
+	}
+
+	c.traceResponse(resp, bodyBytes, time.Since(start))
+	return resp, bodyBytes, nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// PutConfigGroup uploads a config group document as read from a file.
+func (c *Client) PutConfigGroup(ctx context.Context, document interface{}) (model.ConfigGroup, error) {
+	var response model.ConfigGroup
+	err := c.call(ctx, "PutConfigGroup", "POST", true, document, &response)
+	return response, err
+}
+
+func (c *Client) GetConfigGroup(ctx context.Context, reference model.ConfigReference) (model.ConfigGroup, error) {
+	var response model.ConfigGroup
+	err := c.call(ctx, "GetConfigGroup", "GET", true, reference, &response)
+	return response, err
+}
+
+func (c *Client) ListConfigGroup(ctx context.Context, organization, namespace string) (model.ConfigGroupsResponse, error) {
+	var response model.ConfigGroupsResponse
+	err := c.call(ctx, "ListConfigGroup", "GET", true, model.ListConfigRequest{Organization: organization, Namespace: namespace}, &response)
+	return response, err
+}
+
+func (c *Client) DeleteConfigGroup(ctx context.Context, reference model.ConfigReference) (model.ConfigGroup, error) {
+	var response model.ConfigGroup
+	err := c.call(ctx, "DeleteConfigGroup", "DELETE", true, reference, &response)
+	return response, err
+}
+
+func (c *Client) DiffConfigGroup(ctx context.Context, request model.ConfigGroupDiffRequest) (model.ConfigGroupDiffResponse, error) {
+	var response model.ConfigGroupDiffResponse
+	err := c.call(ctx, "DiffConfigGroup", "GET", true, request, &response)
+	return response, err
+}
+
+func (c *Client) PlaceConfigGroup(ctx context.Context, request model.PlaceConfigGroupPlacementsRequest) ([]model.Task, error) {
+	var response model.ConfigGroupPlacementsResponse
+	err := c.call(ctx, "PlaceConfigGroup", "POST", true, request, &response)
+	return response.Tasks, err
+}
+
+func (c *Client) ListPlacementTaskByConfigGroup(ctx context.Context, reference model.ConfigReference) ([]model.Task, error) {
+	var response model.ConfigGroupPlacementsResponse
+	err := c.call(ctx, "ListPlacementTaskByConfigGroup", "GET", true, reference, &response)
+	return response.Tasks, err
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// TokenPlaceholder stands in for the bearer token in dry run requests, so
+// that printed requests do not reveal the stored token.
+const TokenPlaceholder = "$COCKPIT_TOKEN"
+
+// ErrDryRun is returned by every action that would change the system while a
+// dry run handler is set.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// DryRunRequest is the request an action would have sent.
+type DryRunRequest struct {
+	Action  string
+	Method  string
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+// DryRunHandler receives the requests that would change the system instead
+// of the gateway. A non-nil error is returned to the caller in place of
+// ErrDryRun.
+type DryRunHandler func(request DryRunRequest) error
+
+// WithDryRun stops every action that would change the system before it is
+// sent and passes the request to handler. Read actions are still sent, so
+// that commands can work out the changes they would make.
+func WithDryRun(handler DryRunHandler) Option {
+	return func(c *Client) { c.dryRun = handler }
+}
+
+func (c *Client) handleDryRun(action string, authenticated bool, request model.HTTPRequestConfig) error {
+	dryRunRequest := DryRunRequest{
+		Action:  action,
+		Method:  request.Method,
+		URL:     request.URL,
+		Headers: map[string]string{"Content-Type": "application/json"},
+	}
+
+	if authenticated {
+		dryRunRequest.Headers["Authorization"] = "Bearer " + TokenPlaceholder
+	}
+	for key, value := range request.Headers {
+		dryRunRequest.Headers[key] = value
+	}
+
+	if request.RequestBody != nil {
+		body, err := json.Marshal(request.RequestBody)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request body: %v", err)
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, redactBody(body), "", "  "); err != nil {
+			return fmt.Errorf("failed to marshal request body: %v", err)
+		}
+		dryRunRequest.Body = indented.Bytes()
+	}
+
+	if err := c.dryRun(dryRunRequest); err != nil {
+		return err
+	}
+	return ErrDryRun
+}
+
+// DryRun reports whether changing actions are passed to a dry run handler
+// instead of being sent.
+func (c *Client) DryRun() bool {
+	return c.dryRun != nil
+}
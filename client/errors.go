@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Category groups errors by what a caller can do about them.
+type Category int
+
+const (
+	CategoryUnknown Category = iota
+	CategoryAuth
+	CategoryNotFound
+	CategoryConflict
+	CategoryValidation
+	CategoryNetwork
+	CategoryTimeout
+)
+
+var categoryNames = map[Category]string{
+	CategoryUnknown:    "unknown",
+	CategoryAuth:       "auth",
+	CategoryNotFound:   "not-found",
+	CategoryConflict:   "conflict",
+	CategoryValidation: "validation",
+	CategoryNetwork:    "network",
+	CategoryTimeout:    "timeout",
+}
+
+func (c Category) String() string {
+	return categoryNames[c]
+}
+
+// ErrUnauthenticated is returned for authenticated actions when no usable
+// token is available.
+var ErrUnauthenticated = errors.New("not authenticated")
+
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Grpc-Metadata-X-Request-Id"}
+
+// APIError is a non-success answer from the gateway.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = strings.TrimSpace(e.Body)
+	}
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+
+	details := []string{fmt.Sprintf("status %d", e.StatusCode)}
+	if e.Code != "" {
+		details = append(details, "code "+e.Code)
+	}
+	if e.RequestID != "" {
+		details = append(details, "request ID "+e.RequestID)
+	}
+
+	return fmt.Sprintf("%s (%s)", message, strings.Join(details, ", "))
+}
+
+func (e *APIError) Category() Category {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return CategoryAuth
+	case http.StatusNotFound:
+		return CategoryNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return CategoryConflict
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CategoryValidation
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return CategoryTimeout
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return CategoryNetwork
+	}
+	return grpcCategory(e.Code)
+}
+
+// grpcCategory maps the numeric gRPC status codes the gateway forwards from
+// backing services, for answers whose HTTP status says nothing more specific.
+func grpcCategory(code string) Category {
+	switch code {
+	case "7", "16":
+		return CategoryAuth
+	case "5":
+		return CategoryNotFound
+	case "6", "10":
+		return CategoryConflict
+	case "3", "9", "11":
+		return CategoryValidation
+	case "4":
+		return CategoryTimeout
+	case "14":
+		return CategoryNetwork
+	}
+	return CategoryUnknown
+}
+
+// TransportError is returned when the request never got an answer.
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("failed to send request: %v", e.Err)
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+func (e *TransportError) Category() Category {
+	var netErr net.Error
+	if errors.Is(e.Err, context.DeadlineExceeded) || (errors.As(e.Err, &netErr) && netErr.Timeout()) {
+		return CategoryTimeout
+	}
+	return CategoryNetwork
+}
+
+// CategoryOf reports the category of err, looking through wrapped errors.
+func CategoryOf(err error) Category {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Category()
+	}
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) {
+		return transportErr.Category()
+	}
+	if errors.Is(err, ErrUnauthenticated) {
+		return CategoryAuth
+	}
+	return CategoryUnknown
+}
+
+func newAPIError(status int, header http.Header, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: status,
+		Body:       string(body),
+	}
+
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return apiErr
+	}
+
+	apiErr.Code = stringField(payload, "code")
+	apiErr.Message = stringField(payload, "message", "error", "msg")
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = stringField(payload, "requestId", "request_id")
+	}
+	return apiErr
+}
+
+func stringField(payload map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		switch value := payload[key].(type) {
+		case string:
+			if value != "" {
+				return value
+			}
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestCategoryOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Category
+	}{
+		{"nil", nil, CategoryUnknown},
+		{"plain error", errors.New("boom"), CategoryUnknown},
+		{"401", &APIError{StatusCode: http.StatusUnauthorized}, CategoryAuth},
+		{"403", &APIError{StatusCode: http.StatusForbidden}, CategoryAuth},
+		{"404", &APIError{StatusCode: http.StatusNotFound}, CategoryNotFound},
+		{"409", &APIError{StatusCode: http.StatusConflict}, CategoryConflict},
+		{"412", &APIError{StatusCode: http.StatusPreconditionFailed}, CategoryConflict},
+		{"400", &APIError{StatusCode: http.StatusBadRequest}, CategoryValidation},
+		{"422", &APIError{StatusCode: http.StatusUnprocessableEntity}, CategoryValidation},
+		{"408", &APIError{StatusCode: http.StatusRequestTimeout}, CategoryTimeout},
+		{"504", &APIError{StatusCode: http.StatusGatewayTimeout}, CategoryTimeout},
+		{"502", &APIError{StatusCode: http.StatusBadGateway}, CategoryNetwork},
+		{"503", &APIError{StatusCode: http.StatusServiceUnavailable}, CategoryNetwork},
+		{"500 without code", &APIError{StatusCode: http.StatusInternalServerError}, CategoryUnknown},
+		{"status wins over gRPC code", &APIError{StatusCode: http.StatusNotFound, Code: "6"}, CategoryNotFound},
+		{"gRPC permission denied", &APIError{StatusCode: http.StatusInternalServerError, Code: "7"}, CategoryAuth},
+		{"gRPC unauthenticated", &APIError{StatusCode: http.StatusInternalServerError, Code: "16"}, CategoryAuth},
+		{"gRPC not found", &APIError{StatusCode: http.StatusInternalServerError, Code: "5"}, CategoryNotFound},
+		{"gRPC already exists", &APIError{StatusCode: http.StatusInternalServerError, Code: "6"}, CategoryConflict},
+		{"gRPC aborted", &APIError{StatusCode: http.StatusInternalServerError, Code: "10"}, CategoryConflict},
+		{"gRPC invalid argument", &APIError{StatusCode: http.StatusInternalServerError, Code: "3"}, CategoryValidation},
+		{"gRPC failed precondition", &APIError{StatusCode: http.StatusInternalServerError, Code: "9"}, CategoryValidation},
+		{"gRPC out of range", &APIError{StatusCode: http.StatusInternalServerError, Code: "11"}, CategoryValidation},
+		{"gRPC deadline exceeded", &APIError{StatusCode: http.StatusInternalServerError, Code: "4"}, CategoryTimeout},
+		{"gRPC unavailable", &APIError{StatusCode: http.StatusInternalServerError, Code: "14"}, CategoryNetwork},
+		{"gRPC internal", &APIError{StatusCode: http.StatusInternalServerError, Code: "13"}, CategoryUnknown},
+		{"wrapped API error", fmt.Errorf("put: %w", &APIError{StatusCode: http.StatusConflict}), CategoryConflict},
+		{"connection refused", &TransportError{Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, CategoryNetwork},
+		{"deadline exceeded", &TransportError{Err: context.DeadlineExceeded}, CategoryTimeout},
+		{"network timeout", &TransportError{Err: &net.OpError{Op: "read", Err: timeoutError{}}}, CategoryTimeout},
+		{"wrapped transport error", fmt.Errorf("list: %w", &TransportError{Err: context.DeadlineExceeded}), CategoryTimeout},
+		{"unauthenticated", ErrUnauthenticated, CategoryAuth},
+		{"wrapped unauthenticated", fmt.Errorf("claim: %w", ErrUnauthenticated), CategoryAuth},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := CategoryOf(test.err); got != test.want {
+				t.Errorf("CategoryOf(%v) = %s, want %s", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        http.Header
+		body          string
+		wantCode      string
+		wantMessage   string
+		wantRequestID string
+	}{
+		{"gateway error", nil, `{"code":5,"message":"config not found"}`, "5", "config not found", ""},
+		{"error field", nil, `{"error":"bad query"}`, "", "bad query", ""},
+		{"request ID header", http.Header{"X-Request-Id": {"req-1"}}, `{"message":"m","requestId":"req-2"}`, "", "m", "req-1"},
+		{"request ID in body", nil, `{"message":"m","request_id":"req-2"}`, "", "m", "req-2"},
+		{"plain text body", nil, "upstream connect error", "", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiErr := newAPIError(http.StatusInternalServerError, test.header, []byte(test.body))
+			if apiErr.Code != test.wantCode || apiErr.Message != test.wantMessage || apiErr.RequestID != test.wantRequestID {
+				t.Errorf("newAPIError = code %q, message %q, request ID %q; want %q, %q, %q",
+					apiErr.Code, apiErr.Message, apiErr.RequestID, test.wantCode, test.wantMessage, test.wantRequestID)
+			}
+			if apiErr.Body != test.body {
+				t.Errorf("Body = %q, want %q", apiErr.Body, test.body)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func (c *Client) PutStringLabel(ctx context.Context, input model.LabelInput) (model.Node, error) {
+	return c.putLabel(ctx, "PutStringLabel", input)
+}
+
+func (c *Client) PutFloat64Label(ctx context.Context, input model.LabelInput) (model.Node, error) {
+	return c.putLabel(ctx, "PutFloat64Label", input)
+}
+
+func (c *Client) PutBoolLabel(ctx context.Context, input model.LabelInput) (model.Node, error) {
+	return c.putLabel(ctx, "PutBoolLabel", input)
+}
+
+func (c *Client) DeleteLabel(ctx context.Context, input model.DeleteLabelInput) (model.Node, error) {
+	var response model.NodeResponse
+	err := c.call(ctx, "DeleteLabel", "DELETE", true, input, &response)
+	return response.Node, err
+}
+
+func (c *Client) putLabel(ctx context.Context, action string, input model.LabelInput) (model.Node, error) {
+	var response model.NodeResponse
+	err := c.call(ctx, action, "POST", true, input, &response)
+	return response.Node, err
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+const defaultMetricsURL = "http://localhost:8086/api/metrics-api"
+
+func (c *Client) LatestNodeMetrics(ctx context.Context, nodeID string) (model.MetricResponse, error) {
+	return c.latestMetrics(ctx, "LatestNodeMetrics", c.metricsURL+"/latest-node-data/"+nodeID)
+}
+
+func (c *Client) LatestClusterMetrics(ctx context.Context, clusterID string) (model.MetricResponse, error) {
+	return c.latestMetrics(ctx, "LatestClusterMetrics", c.metricsURL+"/latest-cluster-data/"+clusterID)
+}
+
+func (c *Client) latestMetrics(ctx context.Context, action, url string) (model.MetricResponse, error) {
+	var response model.MetricResponse
+
+	err := c.execute(ctx, action, true, model.HTTPRequestConfig{
+		URL:      url,
+		Method:   "GET",
+		Headers:  c.headers,
+		Response: &response,
+		Timeout:  c.timeout,
+	})
+	return response, err
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func (c *Client) ListNodePool(ctx context.Context) ([]model.Node, error) {
+	var response model.NodesResponse
+	err := c.call(ctx, "ListNodePool", "GET", false, nil, &response)
+	return response.Nodes, err
+}
+
+func (c *Client) QueryNodePool(ctx context.Context, query []model.NodeQuery) ([]model.Node, error) {
+	var response model.NodesResponse
+	err := c.call(ctx, "QueryNodePool", "GET", false, model.QueryNodesRequest{Query: query}, &response)
+	return response.Nodes, err
+}
+
+func (c *Client) ListOrgOwnedNodes(ctx context.Context, org string) ([]model.Node, error) {
+	var response model.NodesResponse
+	err := c.call(ctx, "ListOrgOwnedNodes", "GET", true, model.ClaimNodesRequest{Org: org}, &response)
+	return response.Nodes, err
+}
+
+func (c *Client) QueryOrgOwnedNodes(ctx context.Context, org string, query []model.NodeQuery) ([]model.Node, error) {
+	var response model.NodesResponse
+	err := c.call(ctx, "QueryOrgOwnedNodes", "GET", true, model.ClaimNodesRequest{Org: org, Query: query}, &response)
+	return response.Nodes, err
+}
+
+func (c *Client) ClaimOwnership(ctx context.Context, request model.ClaimNodesRequest) ([]model.Node, error) {
+	var response model.ClaimNodesResponse
+	err := c.call(ctx, "ClaimOwnership", "PATCH", true, request, &response)
+	return response.Nodes, err
+}
+
+// ReleaseOwnership returns org-owned nodes to the node pool.
+func (c *Client) ReleaseOwnership(ctx context.Context, request model.ReleaseNodesRequest) ([]model.Node, error) {
+	var response model.ReleaseNodesResponse
+	err := c.call(ctx, "ReleaseOwnership", "PATCH", true, request, &response)
+	return response.Nodes, err
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func (c *Client) CreatePolicy(ctx context.Context, request model.PoliciesRequest) error {
+	return c.call(ctx, "CreatePolicy", "POST", true, request, nil)
+}
+
+func (c *Client) CreateInheritanceRel(ctx context.Context, relation model.Relation) error {
+	return c.call(ctx, "CreateInheritanceRel", "POST", true, relation, nil)
+}
+
+// AddApp creates an app from an app specification document.
+func (c *Client) AddApp(ctx context.Context, document interface{}) error {
+	return c.call(ctx, "AddApp", "POST", true, document, nil)
+}
+
+func (c *Client) RemoveApp(ctx context.Context, reference model.AppReference) error {
+	return c.call(ctx, "RemoveApp", "DELETE", true, reference, nil)
+}
+
+func (c *Client) SetAppResources(ctx context.Context, document interface{}) error {
+	return c.call(ctx, "SetAppResources", "POST", true, document, nil)
+}
+
+// AddNamespace creates a namespace from a namespace document.
+func (c *Client) AddNamespace(ctx context.Context, document interface{}) error {
+	return c.call(ctx, "AddNamespace", "POST", true, document, nil)
+}
+
+func (c *Client) RemoveNamespace(ctx context.Context, reference model.NamespaceReference) error {
+	return c.call(ctx, "RemoveNamespace", "DELETE", true, reference, nil)
+}
+
+func (c *Client) SetNamespaceResources(ctx context.Context, document interface{}) error {
+	return c.call(ctx, "SetNamespaceResources", "POST", true, document, nil)
+}
+
+// GetNamespace returns the namespace document as sent by the gateway.
+func (c *Client) GetNamespace(ctx context.Context, reference model.NamespaceReference) (interface{}, error) {
+	var response interface{}
+	err := c.call(ctx, "GetNamespace", "GET", true, reference, &response)
+	return response, err
+}
+
+func (c *Client) GetNamespaceHierarchy(ctx context.Context, reference model.OrgReference) (interface{}, error) {
+	var response interface{}
+	err := c.call(ctx, "GetNamespaceHierarchy", "GET", true, reference, &response)
+	return response, err
+}
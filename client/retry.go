@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// IdempotencyKeyHeader marks a non-idempotent request as safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+const defaultRetryBaseDelay = 200 * time.Millisecond
+
+// RetryPolicy controls how failed requests are retried. Idempotent requests
+// are retried on connection errors and on 408, 429, 502, 503 and 504 answers;
+// other requests only when they carry an Idempotency-Key header, or when the
+// connection to the gateway could not be established at all.
+type RetryPolicy struct {
+	MaxRetries int
+	MaxWait    time.Duration
+	BaseDelay  time.Duration
+}
+
+var retryableStatuses = map[int]bool{
+	http.StatusRequestTimeout:     true,
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// WithRetries retries failed requests up to maxRetries times, waiting at most
+// maxWait between two attempts.
+func WithRetries(maxRetries int, maxWait time.Duration) Option {
+	return func(c *Client) {
+		c.retry.MaxRetries = maxRetries
+		c.retry.MaxWait = maxWait
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) { c.retry = policy }
+}
+
+func (c *Client) doWithRetry(ctx context.Context, request model.HTTPRequestConfig) (*http.Response, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		resp, body, err := c.do(ctx, request)
+		if attempt >= c.retry.MaxRetries || ctx.Err() != nil || !shouldRetry(request, resp, err) {
+			return resp, body, err
+		}
+
+		timer := time.NewTimer(c.retry.delay(attempt, resp))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, body, err
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(request model.HTTPRequestConfig, resp *http.Response, err error) bool {
+	if err != nil {
+		var transportErr *TransportError
+		if !errors.As(err, &transportErr) {
+			return false
+		}
+		return isIdempotent(request) || isDialError(err)
+	}
+	return retryableStatuses[resp.StatusCode] && isIdempotent(request)
+}
+
+func isIdempotent(request model.HTTPRequestConfig) bool {
+	return idempotentMethods[request.Method] || request.Headers[IdempotencyKeyHeader] != ""
+}
+
+// isDialError reports whether the request failed before reaching the gateway.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// delay returns the wait before the next attempt: the gateway's Retry-After
+// when present, otherwise a jittered exponential backoff, capped at MaxWait.
+func (p RetryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	wait, ok := retryAfter(resp)
+	if !ok {
+		base := p.BaseDelay
+		if base <= 0 {
+			base = defaultRetryBaseDelay
+		}
+		backoff := base << uint(attempt)
+		if backoff <= 0 || (p.MaxWait > 0 && backoff > p.MaxWait) {
+			backoff = p.MaxWait
+		}
+		wait = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	}
+
+	if p.MaxWait > 0 && wait > p.MaxWait {
+		wait = p.MaxWait
+	}
+	return wait
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at), true
+	}
+	return 0, false
+}
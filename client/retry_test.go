@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func TestShouldRetry(t *testing.T) {
+	dialErr := &TransportError{Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	readErr := &TransportError{Err: &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}}
+	withKey := map[string]string{IdempotencyKeyHeader: "key-1"}
+
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		status  int
+		err     error
+		want    bool
+	}{
+		{"GET 503", http.MethodGet, nil, http.StatusServiceUnavailable, nil, true},
+		{"GET 502", http.MethodGet, nil, http.StatusBadGateway, nil, true},
+		{"GET 504", http.MethodGet, nil, http.StatusGatewayTimeout, nil, true},
+		{"GET 408", http.MethodGet, nil, http.StatusRequestTimeout, nil, true},
+		{"GET 429", http.MethodGet, nil, http.StatusTooManyRequests, nil, true},
+		{"GET 500", http.MethodGet, nil, http.StatusInternalServerError, nil, false},
+		{"GET 404", http.MethodGet, nil, http.StatusNotFound, nil, false},
+		{"GET 200", http.MethodGet, nil, http.StatusOK, nil, false},
+		{"PUT 503", http.MethodPut, nil, http.StatusServiceUnavailable, nil, true},
+		{"DELETE 503", http.MethodDelete, nil, http.StatusServiceUnavailable, nil, true},
+		{"POST 503", http.MethodPost, nil, http.StatusServiceUnavailable, nil, false},
+		{"PATCH 503", http.MethodPatch, nil, http.StatusServiceUnavailable, nil, false},
+		{"POST 503 with Idempotency-Key", http.MethodPost, withKey, http.StatusServiceUnavailable, nil, true},
+		{"GET connection reset", http.MethodGet, nil, 0, readErr, true},
+		{"POST connection reset", http.MethodPost, nil, 0, readErr, false},
+		{"POST connection reset with Idempotency-Key", http.MethodPost, withKey, 0, readErr, true},
+		{"POST dial error", http.MethodPost, nil, 0, dialErr, true},
+		{"GET other error", http.MethodGet, nil, 0, errors.New("failed to decode response"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := model.HTTPRequestConfig{Method: test.method, Headers: test.headers}
+			var resp *http.Response
+			if test.err == nil {
+				resp = &http.Response{StatusCode: test.status, Header: http.Header{}}
+			}
+			if got := shouldRetry(request, resp, test.err); got != test.want {
+				t.Errorf("shouldRetry = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	withRetryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": {value}}}
+	}
+
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		attempt  int
+		resp     *http.Response
+		min, max time.Duration
+	}{
+		{"first backoff", RetryPolicy{BaseDelay: 100 * time.Millisecond}, 0, nil, 50 * time.Millisecond, 100 * time.Millisecond},
+		{"third backoff", RetryPolicy{BaseDelay: 100 * time.Millisecond}, 2, nil, 200 * time.Millisecond, 400 * time.Millisecond},
+		{"default base delay", RetryPolicy{}, 0, nil, defaultRetryBaseDelay / 2, defaultRetryBaseDelay},
+		{"backoff capped at MaxWait", RetryPolicy{BaseDelay: time.Second, MaxWait: 2 * time.Second}, 5, nil, time.Second, 2 * time.Second},
+		{"overflowing backoff capped at MaxWait", RetryPolicy{BaseDelay: time.Second, MaxWait: 3 * time.Second}, 70, nil, 1500 * time.Millisecond, 3 * time.Second},
+		{"Retry-After seconds", RetryPolicy{BaseDelay: time.Millisecond}, 0, withRetryAfter("3"), 3 * time.Second, 3 * time.Second},
+		{"Retry-After capped at MaxWait", RetryPolicy{MaxWait: time.Second}, 0, withRetryAfter("30"), time.Second, time.Second},
+		{"Retry-After date", RetryPolicy{}, 0, withRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)), 58 * time.Minute, time.Hour},
+		{"invalid Retry-After", RetryPolicy{BaseDelay: 100 * time.Millisecond}, 0, withRetryAfter("soon"), 50 * time.Millisecond, 100 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				if got := test.policy.delay(test.attempt, test.resp); got < test.min || got > test.max {
+					t.Fatalf("delay = %s, want between %s and %s", got, test.min, test.max)
+				}
+			}
+		})
+	}
+}
+
+func TestDoWithRetryStopsAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	transport := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		attempts++
+		return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	})
+	c := New("http://gateway", loginRoutes(),
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}))
+
+	_, _, err := c.doWithRetry(context.Background(), model.HTTPRequestConfig{URL: "http://gateway/apis", Method: http.MethodGet})
+	if CategoryOf(err) != CategoryNetwork {
+		t.Errorf("err = %v, want a network error", err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
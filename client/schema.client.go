@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func (c *Client) SaveConfigSchema(ctx context.Context, request model.SaveSchemaRequest) error {
+	return c.call(ctx, "SaveConfigSchema", "POST", true, request, nil)
+}
+
+func (c *Client) GetConfigSchema(ctx context.Context, details model.SchemaDetails) (model.SchemaResponse, error) {
+	var response model.SchemaResponse
+	err := c.call(ctx, "GetConfigSchema", "GET", true, model.SchemaDetailsRequest{SchemaDetails: details}, &response)
+	return response, err
+}
+
+func (c *Client) GetConfigSchemaVersions(ctx context.Context, details model.SchemaDetails) (model.SchemaVersionResponse, error) {
+	var response model.SchemaVersionResponse
+	err := c.call(ctx, "GetConfigSchemaVersions", "GET", true, model.SchemaDetailsRequest{SchemaDetails: details}, &response)
+	return response, err
+}
+
+func (c *Client) DeleteConfigSchema(ctx context.Context, details model.SchemaDetails) error {
+	return c.call(ctx, "DeleteConfigSchema", "DELETE", true, model.SchemaDetailsRequest{SchemaDetails: details}, nil)
+}
+
+func (c *Client) ValidateConfiguration(ctx context.Context, request model.ValidateConfigurationRequest) error {
+	return c.call(ctx, "ValidateConfiguration", "GET", true, request, nil)
+}
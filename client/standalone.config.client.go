@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// PutStandaloneConfig uploads a standalone config document as read from a file.
+func (c *Client) PutStandaloneConfig(ctx context.Context, document interface{}) (model.StandaloneConfig, error) {
+	var response model.StandaloneConfig
+	err := c.call(ctx, "PutStandaloneConfig", "POST", true, document, &response)
+	return response, err
+}
+
+func (c *Client) GetStandaloneConfig(ctx context.Context, reference model.ConfigReference) (model.StandaloneConfig, error) {
+	var response model.StandaloneConfig
+	err := c.call(ctx, "GetStandaloneConfig", "GET", true, reference, &response)
+	return response, err
+}
+
+func (c *Client) ListStandaloneConfig(ctx context.Context, organization, namespace string) (model.StandaloneConfigsResponse, error) {
+	var response model.StandaloneConfigsResponse
+	err := c.call(ctx, "ListStandaloneConfig", "GET", true, model.ListConfigRequest{Organization: organization, Namespace: namespace}, &response)
+	return response, err
+}
+
+func (c *Client) DeleteStandaloneConfig(ctx context.Context, reference model.SingleConfigReference) (model.StandaloneConfig, error) {
+	var response model.StandaloneConfig
+	err := c.call(ctx, "DeleteStandaloneConfig", "DELETE", true, reference, &response)
+	return response, err
+}
+
+func (c *Client) DiffStandaloneConfig(ctx context.Context, request model.SingleConfigDiffRequest) (model.StandaloneConfigDiffResponse, error) {
+	var response model.StandaloneConfigDiffResponse
+	err := c.call(ctx, "DiffStandaloneConfig", "GET", true, request, &response)
+	return response, err
+}
+
+func (c *Client) PlaceStandaloneConfig(ctx context.Context, request model.PlaceConfigGroupPlacementsRequest) ([]model.Task, error) {
+	var response model.ConfigGroupPlacementsResponse
+	err := c.call(ctx, "PlaceStandaloneConfig", "POST", true, request, &response)
+	return response.Tasks, err
+}
+
+func (c *Client) ListPlacementTaskByStandaloneConfig(ctx context.Context, reference model.ConfigReference) ([]model.Task, error) {
+	var response model.ConfigGroupPlacementsResponse
+	err := c.call(ctx, "ListPlacementTaskByStandaloneConfig", "GET", true, reference, &response)
+	return response.Tasks, err
+}
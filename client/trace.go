@@ -0,0 +1,149 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Trace levels, each including the output of the previous one.
+const (
+	TraceOff = iota
+	TraceRequests
+	TraceHeaders
+	TraceBodies
+)
+
+const redacted = "[REDACTED]"
+
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// sensitiveFields are the JSON body fields holding credentials, compared in
+// lower case without '-' and '_'.
+var sensitiveFields = map[string]bool{
+	"password":     true,
+	"passphrase":   true,
+	"secret":       true,
+	"token":        true,
+	"accesstoken":  true,
+	"refreshtoken": true,
+	"apikey":       true,
+}
+
+// WithTrace logs every request to w: method, URL, status and timing, plus
+// headers from TraceHeaders and bodies from TraceBodies on. Credentials in
+// headers and JSON bodies are redacted.
+func WithTrace(w io.Writer, level int) Option {
+	return func(c *Client) {
+		c.traceOut = w
+		c.traceLevel = level
+	}
+}
+
+func (c *Client) tracing(level int) bool {
+	return c.traceOut != nil && c.traceLevel >= level
+}
+
+func (c *Client) traceRequest(req *http.Request, body []byte) {
+	if !c.tracing(TraceRequests) {
+		return
+	}
+	fmt.Fprintf(c.traceOut, "> %s %s\n", req.Method, req.URL)
+	if c.tracing(TraceHeaders) {
+		c.traceHeaders(">", req.Header)
+	}
+	if c.tracing(TraceBodies) && len(body) > 0 {
+		fmt.Fprintf(c.traceOut, "> %s\n", redactBody(body))
+	}
+}
+
+func (c *Client) traceResponse(resp *http.Response, body []byte, elapsed time.Duration) {
+	if !c.tracing(TraceRequests) {
+		return
+	}
+	fmt.Fprintf(c.traceOut, "< %s (%s)\n", resp.Status, elapsed.Round(time.Millisecond))
+	if c.tracing(TraceHeaders) {
+		c.traceHeaders("<", resp.Header)
+	}
+	if c.tracing(TraceBodies) && len(body) > 0 {
+		fmt.Fprintf(c.traceOut, "< %s\n", redactBody(body))
+	}
+}
+
+func (c *Client) traceError(err error, elapsed time.Duration) {
+	if !c.tracing(TraceRequests) {
+		return
+	}
+	fmt.Fprintf(c.traceOut, "! %v (%s)\n", err, elapsed.Round(time.Millisecond))
+}
+
+func (c *Client) traceHeaders(prefix string, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := strings.Join(header[name], ", ")
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			value = redactCredential(value)
+		}
+		fmt.Fprintf(c.traceOut, "%s %s: %s\n", prefix, name, value)
+	}
+}
+
+// redactBody replaces the values of credential fields at any depth of a JSON
+// body. Bodies without such fields, or that are not JSON, are kept as they are.
+func redactBody(body []byte) []byte {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil || !redactFields(value) {
+		return body
+	}
+	redactedBody, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return redactedBody
+}
+
+func redactFields(value interface{}) bool {
+	found := false
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			name := strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(key))
+			if sensitiveFields[name] {
+				value[key] = redacted
+				found = true
+			} else if redactFields(field) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if redactFields(item) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+// redactCredential keeps the authentication scheme so traces still show
+// what kind of credential was sent.
+func redactCredential(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " " + redacted
+	}
+	return redacted
+}
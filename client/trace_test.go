@@ -0,0 +1,72 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/c12s/cockpit/config"
+	"github.com/c12s/cockpit/model"
+)
+
+func loginRoutes() *config.Config {
+	return &config.Config{
+		Gateway: config.Gateway{Route: "/apis"},
+		Groups: map[string]map[string]map[string]config.MethodConfig{
+			defaultGroup: {defaultVersion: {"LoginUser": {MethodRoute: "/auth/login", Type: "POST"}}},
+		},
+	}
+}
+
+func TestTraceLoginRedactsCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"token":"secret-token"}`))
+	}))
+	defer server.Close()
+
+	var trace bytes.Buffer
+	c := New(server.URL, loginRoutes(), WithTrace(&trace, TraceBodies))
+	response, err := c.LoginUser(context.Background(), model.Credentials{Username: "alice", Password: "secret-password"})
+	if err != nil {
+		t.Fatalf("LoginUser: %v", err)
+	}
+	if response.Token != "secret-token" {
+		t.Errorf("token = %q, want the unredacted token", response.Token)
+	}
+
+	output := trace.String()
+	for _, secret := range []string{"secret-password", "secret-token"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("trace contains %q:\n%s", secret, output)
+		}
+	}
+	if !strings.Contains(output, "alice") || strings.Count(output, redacted) != 2 {
+		t.Errorf("trace should show the username and redact the password and token:\n%s", output)
+	}
+}
+
+func TestDryRunLoginRedactsPassword(t *testing.T) {
+	var request DryRunRequest
+	c := New("http://localhost:5555", loginRoutes(), WithDryRun(func(r DryRunRequest) error {
+		request = r
+		return nil
+	}))
+	if _, err := c.LoginUser(context.Background(), model.Credentials{Username: "alice", Password: "secret-password"}); err != ErrDryRun {
+		t.Fatalf("LoginUser error = %v, want ErrDryRun", err)
+	}
+	if strings.Contains(string(request.Body), "secret-password") || !strings.Contains(string(request.Body), redacted) {
+		t.Errorf("dry run body does not redact the password:\n%s", request.Body)
+	}
+}
+
+func TestRedactBodyKeepsOtherBodies(t *testing.T) {
+	for _, body := range []string{`{"name":"app","paramSets":[]}`, `not json`, ``} {
+		if got := string(redactBody([]byte(body))); got != body {
+			t.Errorf("redactBody(%q) = %q", body, got)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/utils"
+)
+
+// Login exchanges the credentials for a token and stores it for the active context.
+func Login(username, password string) error {
+	tokenResponse, err := Client().LoginUser(context.Background(), model.Credentials{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send login request: %w", err)
+	}
+
+	if err := SaveToken(username, tokenResponse.Token); err != nil {
+		return fmt.Errorf("failed to save token: %v", err)
+	}
+
+	return nil
+}
+
+// WhoAmI describes the active context's user from the locally stored token.
+func WhoAmI() (model.WhoAmI, error) {
+	whoami := model.WhoAmI{
+		Context: activeContext.Name,
+		Gateway: GatewayAddress(),
+		User:    activeContext.User,
+	}
+
+	token, err := readStoredToken()
+	if err != nil {
+		return whoami, err
+	}
+
+	claims, err := utils.DecodeTokenClaims(token)
+	if err != nil {
+		return whoami, err
+	}
+	whoami.Claims = claims
+
+	return whoami, nil
+}
+
+// relogin asks for the password of the active context's user, stores the new
+// token and caches it for the rest of the process. Callers hold tokenMu.
+func relogin() (string, error) {
+	username := activeContext.User
+	if username == "" {
+		return "", fmt.Errorf("not logged in to context %q, run 'cockpit login' first", activeContext.Name)
+	}
+
+	fmt.Fprintf(os.Stderr, "Session for %q expired, please log in again.\n", username)
+	password, err := utils.PromptForPassword()
+	if err != nil {
+		return "", err
+	}
+
+	if err := Login(username, password); err != nil {
+		return "", err
+	}
+
+	token, err := readStoredToken()
+	if err != nil {
+		return "", err
+	}
+	sessionToken, reloggedIn = token, true
+	return token, nil
+}
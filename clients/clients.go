@@ -2,43 +2,156 @@ package clients
 
 import (
 	"fmt"
+	"os"
+	"strings"
+
+	"github.com/c12s/cockpit/client"
 	"github.com/c12s/cockpit/config"
 	"github.com/c12s/cockpit/utils"
-	"log"
-	"os"
 )
 
-var cfg *config.Config
+const (
+	legacyEnvFile   = ".env"
+	legacyRoutesEnv = "CONFIG_PATH"
+	defaultScheme   = "http"
+	defaultHost     = "localhost"
+	metricsPort     = "8086"
+	metricsRoute    = "/api/metrics-api"
+)
+
+var (
+	cfg              *config.Config
+	activeContext    *config.Context
+	clientConfigPath string
+	gatewayClient    *client.Client
+	clientOptions    []client.Option
+)
 
-func Init() {
-	err := utils.LoadEnvFile(".env")
+// Init resolves the named context (or the current one) from the client config
+// and loads the gateway route configuration it points to.
+func Init(contextName string) error {
+	path, err := config.ClientConfigPath()
 	if err != nil {
-		fmt.Println("Error loading .env file:", err)
-		os.Exit(1)
+		return err
+	}
+	clientConfigPath = path
+
+	clientConfig, err := config.LoadClientConfig(path)
+	if err != nil {
+		return fmt.Errorf("failed to load client config: %v", err)
+	}
+
+	if len(clientConfig.Contexts) == 0 && contextName == "" {
+		activeContext, err = legacyContext()
+		if err != nil {
+			return fmt.Errorf("no contexts configured in %s, run 'cockpit config set-context' first", path)
+		}
+	} else {
+		activeContext, err = clientConfig.ResolveContext(contextName)
+		if err != nil {
+			return err
+		}
 	}
 
-	configPath := os.Getenv("CONFIG_PATH")
-	if configPath == "" {
-		fmt.Println("CONFIG_PATH is not set in the .env file")
-		os.Exit(1)
+	if activeContext.Routes == "" {
+		return fmt.Errorf("context %q has no routes file configured", activeContext.Name)
 	}
 
-	cfg, err = config.LoadConfig(configPath)
+	cfg, err = config.LoadConfig(activeContext.Routes)
 	if err != nil {
-		fmt.Println("Failed to load configuration:", err)
-		os.Exit(1)
+		return fmt.Errorf("failed to load route configuration: %v", err)
+	}
+
+	return nil
+}
+
+// legacyContext keeps the old .env + CONFIG_PATH setup working for users who
+// have not created a client config yet.
+func legacyContext() (*config.Context, error) {
+	if err := utils.LoadEnvFile(legacyEnvFile); err != nil {
+		return nil, err
+	}
+
+	routes := os.Getenv(legacyRoutesEnv)
+	if routes == "" {
+		return nil, fmt.Errorf("%s is not set in the %s file", legacyRoutesEnv, legacyEnvFile)
+	}
+
+	return &config.Context{Name: "default", Routes: routes}, nil
+}
+
+// Configure adds options applied when the gateway client is built.
+func Configure(opts ...client.Option) {
+	clientOptions = append(clientOptions, opts...)
+}
+
+func CurrentContext() *config.Context {
+	return activeContext
+}
+
+// GatewayAddress returns scheme://host:port of the active context's gateway.
+func GatewayAddress() string {
+	return fmt.Sprintf("%s://%s:%s", gatewayScheme(), gatewayHost(), gatewayPort())
+}
+
+// Client returns the gateway client of the active context. Authenticated
+// actions use the stored token of the context's user.
+func Client() *client.Client {
+	if gatewayClient != nil {
+		return gatewayClient
 	}
+
+	opts := []client.Option{
+		client.WithRoute(gatewayRoute()),
+		client.WithMetricsURL(metricsURL()),
+		client.WithTokenSource(ReadToken),
+	}
+	if activeContext.AutoRelogin {
+		opts = append(opts, client.WithReauthenticator(reauthenticate))
+	}
+
+	opts = append(opts, clientOptions...)
+
+	gatewayClient = client.New(GatewayAddress(), cfg, opts...)
+	return gatewayClient
 }
 
-func BuildURL(group, version, action string) string {
-	gateway := fmt.Sprintf("http://%s:%s", "localhost", cfg.Gateway.Port)
+func gatewayScheme() string {
+	if activeContext.Gateway.Scheme != "" {
+		return activeContext.Gateway.Scheme
+	}
+	if activeContext.TLS.Enabled() {
+		return "https"
+	}
+	return defaultScheme
+}
+
+func gatewayHost() string {
+	if activeContext.Gateway.Host != "" {
+		return activeContext.Gateway.Host
+	}
+	return defaultHost
+}
 
-	methodConfig, ok := cfg.Groups[group][version][action]
-	if !ok {
-		log.Fatalf("Configuration for %s/%s/%s not found", group, version, action)
+func gatewayPort() string {
+	if activeContext.Gateway.Port != "" {
+		return activeContext.Gateway.Port
 	}
+	return cfg.Gateway.Port
+}
 
-	fullMethodRoute := fmt.Sprintf("%s/%s/%s%s", cfg.Gateway.Route, group, version, methodConfig.MethodRoute)
+// metricsURL returns the context's metrics API, which runs next to the
+// gateway unless the context sets another URL.
+func metricsURL() string {
+	if activeContext.Metrics != "" {
+		return strings.TrimSuffix(activeContext.Metrics, "/")
+	}
+	return fmt.Sprintf("%s://%s:%s%s", gatewayScheme(), gatewayHost(), metricsPort, metricsRoute)
+}
 
-	return fmt.Sprintf("%s%s", gateway, fullMethodRoute)
+func gatewayRoute() string {
+	if activeContext.Gateway.Route != "" {
+		return activeContext.Gateway.Route
+	}
+	return cfg.Gateway.Route
 }
@@ -0,0 +1,169 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/utils"
+)
+
+// configKind holds the gateway actions used on one kind of config. get
+// returns the params of a version in the document shape put expects, and
+// versions lists the versions of a config.
+type configKind struct {
+	kind          string
+	placementKind string
+	get           func(ctx context.Context, reference model.ConfigReference) (map[string]interface{}, error)
+	put           func(ctx context.Context, document interface{}) error
+	versions      func(ctx context.Context, organization, namespace, name string) ([]string, error)
+}
+
+var configGroupKind = configKind{
+	kind:          model.KindConfigGroup,
+	placementKind: model.KindConfigGroupPlacement,
+	get: func(ctx context.Context, reference model.ConfigReference) (map[string]interface{}, error) {
+		group, err := Client().GetConfigGroup(ctx, reference)
+		return map[string]interface{}{"paramSets": group.ParamSets}, err
+	},
+	put: func(ctx context.Context, document interface{}) error {
+		_, err := Client().PutConfigGroup(ctx, document)
+		return err
+	},
+	versions: func(ctx context.Context, organization, namespace, name string) ([]string, error) {
+		response, err := Client().ListConfigGroup(ctx, organization, namespace)
+		var versions []string
+		for _, group := range response.Groups {
+			if group.Name == name {
+				versions = append(versions, group.Version)
+			}
+		}
+		return versions, err
+	},
+}
+
+var standaloneConfigKind = configKind{
+	kind:          model.KindStandaloneConfig,
+	placementKind: model.KindStandaloneConfigPlacement,
+	get: func(ctx context.Context, reference model.ConfigReference) (map[string]interface{}, error) {
+		config, err := Client().GetStandaloneConfig(ctx, reference)
+		return map[string]interface{}{"paramSet": config.ParamSet}, err
+	},
+	put: func(ctx context.Context, document interface{}) error {
+		_, err := Client().PutStandaloneConfig(ctx, document)
+		return err
+	},
+	versions: func(ctx context.Context, organization, namespace, name string) ([]string, error) {
+		response, err := Client().ListStandaloneConfig(ctx, organization, namespace)
+		var versions []string
+		for _, config := range response.Configurations {
+			if config.Name == name {
+				versions = append(versions, config.Version)
+			}
+		}
+		return versions, err
+	},
+}
+
+// PrepareConfigGroupVersion sets the version of a config group document
+// before it is put. Without bump, the document is left as it is. With bump,
+// the version is the next one after the file's version and the existing ones
+// of the group, see utils.NextVersion, and unless allowExisting is set, a
+// version that already exists fails with utils.ErrExists.
+func PrepareConfigGroupVersion(ctx context.Context, document map[string]interface{}, bump string, allowExisting bool) error {
+	return prepareConfigVersion(ctx, configGroupKind, document, bump, allowExisting)
+}
+
+// PrepareStandaloneConfigVersion is PrepareConfigGroupVersion for standalone
+// configs.
+func PrepareStandaloneConfigVersion(ctx context.Context, document map[string]interface{}, bump string, allowExisting bool) error {
+	return prepareConfigVersion(ctx, standaloneConfigKind, document, bump, allowExisting)
+}
+
+func prepareConfigVersion(ctx context.Context, kind configKind, document map[string]interface{}, bump string, allowExisting bool) error {
+	if bump == "" {
+		return nil
+	}
+
+	organization, _ := document["organization"].(string)
+	namespace, _ := document["namespace"].(string)
+	name, _ := document["name"].(string)
+	version, _ := document["version"].(string)
+	if organization == "" || namespace == "" || name == "" {
+		return &utils.ValidationError{Message: "the config sets no organization, namespace or name"}
+	}
+
+	versions, err := kind.versions(ctx, organization, namespace, name)
+	if err != nil && client.CategoryOf(err) != client.CategoryNotFound {
+		return err
+	}
+	version = utils.NextVersion(versions, version, bump)
+	document["version"] = version
+
+	if !allowExisting {
+		for _, existing := range versions {
+			if existing == version {
+				return fmt.Errorf("%s %s: %w, use --allow-existing to put it again", strings.ToLower(kind.kind), utils.ConfigReferenceName(organization, namespace, name, version), utils.ErrExists)
+			}
+		}
+	}
+	return nil
+}
+
+// RollbackConfigGroup puts the params of a config group version again under
+// the version as, and places the new version with the strategy the group was
+// last placed with when place is set. Dry runs return the results with
+// client.ErrDryRun.
+func RollbackConfigGroup(ctx context.Context, reference model.ConfigReference, as string, place bool) ([]model.Result, error) {
+	return rollbackConfig(ctx, configGroupKind, reference, as, place)
+}
+
+// RollbackStandaloneConfig is RollbackConfigGroup for standalone configs.
+func RollbackStandaloneConfig(ctx context.Context, reference model.ConfigReference, as string, place bool) ([]model.Result, error) {
+	return rollbackConfig(ctx, standaloneConfigKind, reference, as, place)
+}
+
+func rollbackConfig(ctx context.Context, kind configKind, reference model.ConfigReference, as string, place bool) ([]model.Result, error) {
+	document, err := kind.get(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", strings.ToLower(kind.kind), utils.ConfigReferenceName(reference.Organization, reference.Namespace, reference.Name, reference.Version), err)
+	}
+
+	target := reference
+	target.Version = as
+	name := utils.ConfigReferenceName(target.Organization, target.Namespace, target.Name, target.Version)
+	if _, err := kind.get(ctx, target); err == nil {
+		return nil, fmt.Errorf("%s %s: %w", strings.ToLower(kind.kind), name, utils.ErrExists)
+	} else if client.CategoryOf(err) != client.CategoryNotFound {
+		return nil, err
+	}
+
+	var strategy model.PlacementStrategy
+	if place {
+		if strategy, err = LastPlacementStrategy(kind.placementKind, reference); err != nil {
+			return nil, err
+		}
+	}
+
+	document["organization"] = target.Organization
+	document["namespace"] = target.Namespace
+	document["name"] = target.Name
+	document["version"] = target.Version
+	putErr := kind.put(ctx, document)
+	if putErr != nil && !errors.Is(putErr, client.ErrDryRun) {
+		return nil, putErr
+	}
+	results := []model.Result{{Kind: strings.ToLower(kind.kind), Name: name, Status: model.StatusCreated}}
+
+	if place {
+		request := model.PlaceConfigGroupPlacementsRequest{Config: target, Strategy: strategy}
+		if _, err := PlaceConfig(ctx, kind.placementKind, request); err != nil && !errors.Is(err, client.ErrDryRun) {
+			return results, err
+		}
+		results = append(results, model.Result{Kind: strings.ToLower(kind.placementKind), Name: name, Status: model.StatusCreated})
+	}
+	return results, putErr
+}
@@ -0,0 +1,69 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/c12s/cockpit/client"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Connection holds the transport settings of the gateway client, starting
+// from the active context and overridable by command line flags.
+type Connection struct {
+	Timeout    time.Duration
+	Transport  client.TransportOptions
+	Headers    map[string]string
+	TraceLevel int
+	DryRun     client.DryRunHandler
+}
+
+func ContextConnection() (Connection, error) {
+	conn := Connection{
+		Timeout: defaultTimeout,
+		Transport: client.TransportOptions{
+			CAFile:             activeContext.TLS.CAFile,
+			CertFile:           activeContext.TLS.CertFile,
+			KeyFile:            activeContext.TLS.KeyFile,
+			InsecureSkipVerify: activeContext.TLS.InsecureSkipVerify,
+			Proxy:              activeContext.Proxy,
+		},
+	}
+
+	if activeContext.Timeout != "" {
+		timeout, err := time.ParseDuration(activeContext.Timeout)
+		if err != nil {
+			return conn, fmt.Errorf("invalid timeout %q in context %q: %v", activeContext.Timeout, activeContext.Name, err)
+		}
+		conn.Timeout = timeout
+	}
+
+	return conn, nil
+}
+
+// Connect builds the shared transport for conn and applies it to the gateway
+// client.
+func Connect(conn Connection) error {
+	transport, err := client.NewTransport(conn.Transport)
+	if err != nil {
+		return err
+	}
+
+	if conn.Transport.InsecureSkipVerify {
+		fmt.Fprintln(os.Stderr, "Warning: TLS certificate verification is disabled")
+	}
+
+	Configure(
+		client.WithHTTPClient(&http.Client{Transport: transport}),
+		client.WithTimeout(conn.Timeout),
+		client.WithHeaders(conn.Headers),
+		client.WithTrace(os.Stderr, conn.TraceLevel),
+	)
+	if conn.DryRun != nil {
+		Configure(client.WithDryRun(conn.DryRun))
+	}
+	return nil
+}
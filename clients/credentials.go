@@ -0,0 +1,189 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/c12s/cockpit/config"
+	"github.com/c12s/cockpit/credentials"
+	"github.com/c12s/cockpit/utils"
+)
+
+const (
+	passphraseEnv = "COCKPIT_PASSPHRASE"
+	expiryWarning = 5 * time.Minute
+)
+
+var (
+	credentialStoreOnce sync.Once
+	credentialStore     credentials.Store
+	credentialStoreErr  error
+
+	// tokenMu serializes token reads and re-logins, so that requests sent in
+	// parallel share a single passphrase or password prompt. relogin, which
+	// runs with tokenMu held, caches the new token in sessionToken.
+	tokenMu      sync.Mutex
+	sessionToken string
+	reloggedIn   bool
+)
+
+// ReadToken returns the stored token of the active context's user. Tokens
+// close to expiry produce a warning; expired ones are refreshed through a
+// password prompt when the context has auto-relogin enabled. The token is
+// read once per process.
+func ReadToken() (string, error) {
+	tokenMu.Lock()
+	defer tokenMu.Unlock()
+
+	if sessionToken != "" {
+		return sessionToken, nil
+	}
+	token, err := readToken()
+	if err != nil {
+		return "", err
+	}
+	sessionToken = token
+	return token, nil
+}
+
+// reauthenticate logs in again after the gateway rejected the token. Requests
+// rejected in parallel wait for the first re-login and use its token.
+func reauthenticate(context.Context) (string, error) {
+	tokenMu.Lock()
+	defer tokenMu.Unlock()
+
+	if reloggedIn {
+		return sessionToken, nil
+	}
+	return relogin()
+}
+
+func readToken() (string, error) {
+	token, err := readStoredToken()
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := utils.DecodeTokenClaims(token)
+	if err != nil {
+		return token, nil
+	}
+
+	expiry, ok := claims.Expiry()
+	if !ok {
+		return token, nil
+	}
+
+	remaining := time.Until(expiry)
+	if remaining <= 0 {
+		if activeContext.AutoRelogin {
+			return relogin()
+		}
+		return "", fmt.Errorf("token for %q expired at %s, run 'cockpit login'", activeContext.User, expiry.Format(time.RFC3339))
+	}
+	if remaining < expiryWarning {
+		fmt.Fprintf(os.Stderr, "Warning: token for %q expires in %s\n", activeContext.User, remaining.Round(time.Second))
+	}
+
+	return token, nil
+}
+
+func readStoredToken() (string, error) {
+	if activeContext.User == "" {
+		return "", fmt.Errorf("not logged in to context %q, run 'cockpit login' first", activeContext.Name)
+	}
+
+	store, err := openCredentialStore()
+	if err != nil {
+		return "", err
+	}
+
+	token, err := store.Get(credentials.Key(GatewayAddress(), activeContext.User))
+	if errors.Is(err, credentials.ErrNotFound) {
+		return "", fmt.Errorf("no token stored for %q at %s, run 'cockpit login' first", activeContext.User, GatewayAddress())
+	}
+	return token, err
+}
+
+// SaveToken stores the token for username and makes username the user of the
+// active context.
+func SaveToken(username, token string) error {
+	store, err := openCredentialStore()
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(credentials.Key(GatewayAddress(), username), token); err != nil {
+		return fmt.Errorf("failed to store token: %v", err)
+	}
+
+	return setContextUser(username)
+}
+
+// DeleteToken removes the active user's token and detaches the user from the
+// context. It returns the user that was logged out.
+func DeleteToken() (string, error) {
+	username := activeContext.User
+	if username == "" {
+		return "", fmt.Errorf("not logged in to context %q", activeContext.Name)
+	}
+
+	store, err := openCredentialStore()
+	if err != nil {
+		return "", err
+	}
+
+	err = store.Delete(credentials.Key(GatewayAddress(), username))
+	if err != nil && !errors.Is(err, credentials.ErrNotFound) {
+		return "", fmt.Errorf("failed to delete token: %v", err)
+	}
+
+	return username, setContextUser("")
+}
+
+// openCredentialStore opens the active context's credential store once per
+// process, so that the passphrase of an encrypted store is asked only once.
+func openCredentialStore() (credentials.Store, error) {
+	credentialStoreOnce.Do(func() {
+		dir, err := config.ConfigDir()
+		if err != nil {
+			credentialStoreErr = err
+			return
+		}
+
+		credentialStore, credentialStoreErr = credentials.Open(activeContext.CredentialStore, credentials.Options{
+			Dir:        dir,
+			Passphrase: readPassphrase,
+		})
+	})
+	return credentialStore, credentialStoreErr
+}
+
+func readPassphrase() (string, error) {
+	if passphrase := os.Getenv(passphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+	return utils.PromptForSecret("Enter credential store passphrase: ")
+}
+
+// setContextUser records the logged in user on the active context. A context
+// synthesized from a legacy .env file is written to the client config so the
+// login survives changing directories.
+func setContextUser(username string) error {
+	clientConfig, err := config.LoadClientConfig(clientConfigPath)
+	if err != nil {
+		return err
+	}
+
+	activeContext.User = username
+	clientConfig.SetContext(*activeContext)
+	if clientConfig.CurrentContext == "" {
+		clientConfig.CurrentContext = activeContext.Name
+	}
+
+	return clientConfig.Save(clientConfigPath)
+}
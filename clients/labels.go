@@ -0,0 +1,95 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/c12s/cockpit/client"
+
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/utils"
+)
+
+// PutTypedLabel puts a label through the gateway action of its value type.
+func PutTypedLabel(ctx context.Context, org, nodeID string, label model.TypedLabel) (model.Node, error) {
+	input := model.LabelInput{
+		Label:  model.Label{Key: label.Key, Value: label.Value},
+		NodeID: nodeID,
+		Org:    org,
+	}
+
+	valueType, err := utils.ParseLabelType(label.Type)
+	if err != nil {
+		return model.Node{}, err
+	}
+
+	switch valueType {
+	case utils.LabelTypeString:
+		return Client().PutStringLabel(ctx, input)
+	case utils.LabelTypeFloat:
+		return Client().PutFloat64Label(ctx, input)
+	case utils.LabelTypeBool:
+		return Client().PutBoolLabel(ctx, input)
+	}
+	return model.Node{}, fmt.Errorf("unknown label type %q", valueType)
+}
+
+// ApplyLabelChanges applies the planned changes of org's nodes, working on up
+// to parallelism nodes at a time. The changes of a single node are applied in
+// order and stop at the first failure. The returned error is the first
+// failure in node order. Dry runs report the changes as applied.
+func ApplyLabelChanges(ctx context.Context, org string, plan []model.NodeLabelChanges, parallelism int) ([]model.LabelChangeResult, error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]model.LabelChangeResult, len(plan))
+	errs := make([]error, len(plan))
+	slots := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, changes := range plan {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, changes model.NodeLabelChanges) {
+			defer wg.Done()
+			results[i], errs[i] = applyNodeLabelChanges(ctx, org, changes)
+			<-slots
+		}(i, changes)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+func applyNodeLabelChanges(ctx context.Context, org string, changes model.NodeLabelChanges) (model.LabelChangeResult, error) {
+	result := model.LabelChangeResult{
+		NodeID:    changes.NodeID,
+		Put:       []string{},
+		Deleted:   []string{},
+		Unchanged: changes.Unchanged,
+	}
+
+	for _, label := range changes.Put {
+		if _, err := PutTypedLabel(ctx, org, changes.NodeID, label); err != nil && !errors.Is(err, client.ErrDryRun) {
+			result.Error = fmt.Sprintf("putting %s: %v", label.Key, err)
+			return result, fmt.Errorf("node %s: %s", changes.NodeID, result.Error)
+		}
+		result.Put = append(result.Put, label.Key)
+	}
+	for _, key := range changes.Delete {
+		input := model.DeleteLabelInput{LabelKey: key, NodeID: changes.NodeID, Org: org}
+		if _, err := Client().DeleteLabel(ctx, input); err != nil && !errors.Is(err, client.ErrDryRun) {
+			result.Error = fmt.Sprintf("deleting %s: %v", key, err)
+			return result, fmt.Errorf("node %s: %s", changes.NodeID, result.Error)
+		}
+		result.Deleted = append(result.Deleted, key)
+	}
+	return result, nil
+}
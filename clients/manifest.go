@@ -0,0 +1,251 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/utils"
+)
+
+// ApplyManifest creates the object a manifest describes, or updates it when
+// it differs from the existing one, and returns the resulting status. Config
+// versions cannot be updated, so a stored version with different params fails
+// with utils.ErrExists. Namespaces, apps, relations and policies are not
+// compared and are reported as existing. Dry runs return the status with
+// client.ErrDryRun.
+func ApplyManifest(ctx context.Context, manifest model.Manifest) (string, error) {
+	c := Client()
+	switch manifest.Kind {
+	case model.KindNamespace:
+		var reference model.NamespaceReference
+		if err := utils.DecodeManifest(manifest, &reference); err != nil {
+			return "", err
+		}
+		_, err := c.GetNamespace(ctx, reference)
+		switch {
+		case err == nil:
+			return model.StatusExists, nil
+		case client.CategoryOf(err) != client.CategoryNotFound:
+			return "", err
+		}
+		return model.StatusCreated, c.AddNamespace(ctx, manifest.Spec)
+
+	case model.KindApp:
+		return createUnlessConflict(c.AddApp(ctx, manifest.Spec))
+
+	case model.KindRelation:
+		var relation model.Relation
+		if err := utils.DecodeManifest(manifest, &relation); err != nil {
+			return "", err
+		}
+		return createUnlessConflict(c.CreateInheritanceRel(ctx, relation))
+
+	case model.KindPolicy:
+		var policy model.PoliciesRequest
+		if err := utils.DecodeManifest(manifest, &policy); err != nil {
+			return "", err
+		}
+		return createUnlessConflict(c.CreatePolicy(ctx, policy))
+
+	case model.KindSchema:
+		var document model.SchemaDocument
+		if err := utils.DecodeManifest(manifest, &document); err != nil {
+			return "", err
+		}
+		return putUnlessExists(func() (bool, error) {
+			existing, err := c.GetConfigSchema(ctx, document.SchemaDetails)
+			return strings.TrimSpace(existing.SchemaData.Schema) == strings.TrimSpace(document.Schema), err
+		}, func() error {
+			return c.SaveConfigSchema(ctx, model.SaveSchemaRequest{SchemaDetails: document.SchemaDetails, Schema: document.Schema})
+		})
+
+	case model.KindConfigGroup:
+		var group model.ConfigGroup
+		if err := utils.DecodeManifest(manifest, &group); err != nil {
+			return "", err
+		}
+		return putVersionUnlessExists(func() (bool, error) {
+			existing, err := c.GetConfigGroup(ctx, configReference(group.Organization, group.Namespace, group.Name, group.Version))
+			return sameParamSets(existing.ParamSets, group.ParamSets), err
+		}, func() error {
+			_, err := c.PutConfigGroup(ctx, manifest.Spec)
+			return err
+		})
+
+	case model.KindStandaloneConfig:
+		var config model.StandaloneConfig
+		if err := utils.DecodeManifest(manifest, &config); err != nil {
+			return "", err
+		}
+		return putVersionUnlessExists(func() (bool, error) {
+			existing, err := c.GetStandaloneConfig(ctx, configReference(config.Organization, config.Namespace, config.Name, config.Version))
+			return sameParams(existing.ParamSet, config.ParamSet), err
+		}, func() error {
+			_, err := c.PutStandaloneConfig(ctx, manifest.Spec)
+			return err
+		})
+
+	case model.KindConfigGroupPlacement, model.KindStandaloneConfigPlacement:
+		var request model.PlaceConfigGroupPlacementsRequest
+		if err := utils.DecodeManifest(manifest, &request); err != nil {
+			return "", err
+		}
+		list := c.ListPlacementTaskByConfigGroup
+		if manifest.Kind == model.KindStandaloneConfigPlacement {
+			list = c.ListPlacementTaskByStandaloneConfig
+		}
+		tasks, err := list(ctx, request.Config)
+		if err != nil && client.CategoryOf(err) != client.CategoryNotFound {
+			return "", err
+		}
+		if len(tasks) > 0 {
+			return model.StatusUnchanged, nil
+		}
+		_, err = PlaceConfig(ctx, manifest.Kind, request)
+		return model.StatusCreated, err
+	}
+	return "", &utils.ManifestError{Path: manifest.Source, Message: "unknown kind " + manifest.Kind}
+}
+
+// putUnlessExists looks the object up with get, which reports whether the
+// existing object matches the manifest, and calls put when it is missing or
+// different.
+func putUnlessExists(get func() (bool, error), put func() error) (string, error) {
+	status := model.StatusCreated
+	same, err := get()
+	switch {
+	case err == nil && same:
+		return model.StatusUnchanged, nil
+	case err == nil:
+		status = model.StatusUpdated
+	case client.CategoryOf(err) != client.CategoryNotFound:
+		return "", err
+	}
+	return status, put()
+}
+
+// putVersionUnlessExists looks a config version up with get, which reports
+// whether the stored version has the params of the manifest, and calls put
+// when it is missing. Stored versions are never put again.
+func putVersionUnlessExists(get func() (bool, error), put func() error) (string, error) {
+	same, err := get()
+	switch {
+	case err == nil && same:
+		return model.StatusUnchanged, nil
+	case err == nil:
+		return "", fmt.Errorf("version %w with different params, bump the version", utils.ErrExists)
+	case client.CategoryOf(err) != client.CategoryNotFound:
+		return "", err
+	}
+	return model.StatusCreated, put()
+}
+
+func createUnlessConflict(err error) (string, error) {
+	if client.CategoryOf(err) == client.CategoryConflict {
+		return model.StatusExists, nil
+	}
+	return model.StatusCreated, err
+}
+
+func configReference(organization, namespace, name, version string) model.ConfigReference {
+	return model.ConfigReference{Organization: organization, Namespace: namespace, Name: name, Version: version}
+}
+
+func sameParamSets(existing, wanted []model.ParamSet) bool {
+	if len(existing) != len(wanted) {
+		return false
+	}
+	byName := make(map[string][]model.Param, len(existing))
+	for _, set := range existing {
+		byName[set.Name] = set.ParamSet
+	}
+	for _, set := range wanted {
+		params, ok := byName[set.Name]
+		if !ok || !sameParams(params, set.ParamSet) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameParams(existing, wanted []model.Param) bool {
+	return reflect.DeepEqual(sortedParams(existing), sortedParams(wanted))
+}
+
+func sortedParams(params []model.Param) []model.Param {
+	sorted := append([]model.Param{}, params...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+	return sorted
+}
+
+// DeleteManifest deletes the object a manifest describes and returns the
+// resulting status. Kinds the gateway cannot delete are skipped, and missing
+// objects are reported as not found when ignoreNotFound is set. Dry runs
+// return the status with client.ErrDryRun.
+func DeleteManifest(ctx context.Context, manifest model.Manifest, ignoreNotFound bool) (string, error) {
+	err := deleteManifest(ctx, manifest)
+	switch {
+	case errors.Is(err, errNotDeletable):
+		return model.StatusSkipped, nil
+	case errors.Is(err, client.ErrDryRun):
+		return model.StatusDeleted, err
+	case err != nil && ignoreNotFound && client.CategoryOf(err) == client.CategoryNotFound:
+		return model.StatusNotFound, nil
+	case err != nil:
+		return "", err
+	}
+	return model.StatusDeleted, nil
+}
+
+var errNotDeletable = errors.New("kind cannot be deleted")
+
+func deleteManifest(ctx context.Context, manifest model.Manifest) error {
+	c := Client()
+	switch manifest.Kind {
+	case model.KindNamespace:
+		var reference model.NamespaceReference
+		if err := utils.DecodeManifest(manifest, &reference); err != nil {
+			return err
+		}
+		return c.RemoveNamespace(ctx, reference)
+
+	case model.KindApp:
+		var reference model.AppReference
+		if err := utils.DecodeManifest(manifest, &reference); err != nil {
+			return err
+		}
+		return c.RemoveApp(ctx, reference)
+
+	case model.KindSchema:
+		var document model.SchemaDocument
+		if err := utils.DecodeManifest(manifest, &document); err != nil {
+			return err
+		}
+		return c.DeleteConfigSchema(ctx, document.SchemaDetails)
+
+	case model.KindConfigGroup:
+		var reference model.ConfigReference
+		if err := utils.DecodeManifest(manifest, &reference); err != nil {
+			return err
+		}
+		_, err := c.DeleteConfigGroup(ctx, reference)
+		return err
+
+	case model.KindStandaloneConfig:
+		var reference model.SingleConfigReference
+		if err := utils.DecodeManifest(manifest, &reference); err != nil {
+			return err
+		}
+		_, err := c.DeleteStandaloneConfig(ctx, reference)
+		return err
+	}
+	// Relations, policies and placements have no delete action; placements
+	// go with their configs.
+	return errNotDeletable
+}
@@ -0,0 +1,125 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/utils"
+)
+
+// FindNode looks a node up among the nodes owned by org, when org is set,
+// and then in the node pool.
+func FindNode(ctx context.Context, id, org string) (model.Node, error) {
+	var lookupErr error
+	if org != "" {
+		nodes, err := Client().ListOrgOwnedNodes(ctx, org)
+		if err == nil {
+			if node, ok := findNode(nodes, id); ok {
+				return node, nil
+			}
+		}
+		lookupErr = err
+	}
+
+	nodes, err := Client().ListNodePool(ctx)
+	if err != nil {
+		return model.Node{}, err
+	}
+	if node, ok := findNode(nodes, id); ok {
+		return node, nil
+	}
+	if lookupErr != nil {
+		return model.Node{}, lookupErr
+	}
+
+	if org != "" {
+		return model.Node{}, fmt.Errorf("node %s %w in the node pool or among the nodes of %s", id, utils.ErrNotFound, org)
+	}
+	return model.Node{}, fmt.Errorf("node %s %w in the node pool", id, utils.ErrNotFound)
+}
+
+func findNode(nodes []model.Node, id string) (model.Node, bool) {
+	for _, node := range nodes {
+		if node.ID == id {
+			return node, true
+		}
+	}
+	return model.Node{}, false
+}
+
+// DescribeNode gathers a node's labels and owner, the placement tasks of the
+// configurations in org/namespace that targeted it, and its latest metrics.
+// Only a missing node is an error; the other parts are reported as warnings.
+func DescribeNode(ctx context.Context, id, org, namespace string) (model.NodeDescription, error) {
+	node, err := FindNode(ctx, id, org)
+	if err != nil {
+		return model.NodeDescription{}, err
+	}
+
+	description := model.NodeDescription{
+		Node:       node,
+		Owner:      node.Org,
+		Placements: []model.NodePlacement{},
+	}
+
+	switch {
+	case description.Owner == "":
+	case namespace == "":
+		description.Warnings = append(description.Warnings, "placements were not looked up: no namespace is set")
+	default:
+		placements, warnings := nodePlacements(ctx, id, description.Owner, namespace)
+		description.Placements = placements
+		description.Warnings = append(description.Warnings, warnings...)
+	}
+
+	metrics, err := Client().LatestNodeMetrics(ctx, id)
+	if err != nil {
+		description.Warnings = append(description.Warnings, fmt.Sprintf("metrics could not be fetched: %v", err))
+	}
+	description.Metrics = metrics
+
+	return description, nil
+}
+
+// nodePlacements collects the placement tasks for the node from every config
+// group and standalone config in the namespace.
+func nodePlacements(ctx context.Context, id, org, namespace string) ([]model.NodePlacement, []string) {
+	placements := []model.NodePlacement{}
+	var warnings []string
+
+	collect := func(kind string, reference model.ConfigReference, tasks []model.Task, err error) {
+		name := fmt.Sprintf("%s/%s/%s@%s", reference.Organization, reference.Namespace, reference.Name, reference.Version)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("placements of %s %s could not be fetched: %v", kind, name, err))
+			return
+		}
+		for _, task := range tasks {
+			if task.Node == id {
+				placements = append(placements, model.NodePlacement{Kind: kind, Config: name, Task: task})
+			}
+		}
+	}
+
+	groups, err := Client().ListConfigGroup(ctx, org, namespace)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("config groups could not be listed: %v", err))
+	}
+	for _, group := range groups.Groups {
+		reference := model.ConfigReference{Organization: org, Namespace: namespace, Name: group.Name, Version: group.Version}
+		tasks, err := Client().ListPlacementTaskByConfigGroup(ctx, reference)
+		collect("config-group", reference, tasks, err)
+	}
+
+	configs, err := Client().ListStandaloneConfig(ctx, org, namespace)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("standalone configs could not be listed: %v", err))
+	}
+	for _, config := range configs.Configurations {
+		reference := model.ConfigReference{Organization: org, Namespace: namespace, Name: config.Name, Version: config.Version}
+		tasks, err := Client().ListPlacementTaskByStandaloneConfig(ctx, reference)
+		collect("standalone-config", reference, tasks, err)
+	}
+
+	return placements, warnings
+}
@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/c12s/cockpit/config"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// placementsFile keeps the strategy each config was last placed with, which
+// the gateway does not return, so that rollbacks can place configs again.
+const placementsFile = "placements.yaml"
+
+// PlaceConfig places a config group, or a standalone config when kind is
+// model.KindStandaloneConfigPlacement, and records the strategy it used.
+func PlaceConfig(ctx context.Context, kind string, request model.PlaceConfigGroupPlacementsRequest) ([]model.Task, error) {
+	place := Client().PlaceConfigGroup
+	if kind == model.KindStandaloneConfigPlacement {
+		place = Client().PlaceStandaloneConfig
+	}
+	tasks, err := place(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordPlacement(kind, request); err != nil {
+		fmt.Fprintln(os.Stderr, "Warning: failed to record the placement strategy:", err)
+	}
+	return tasks, nil
+}
+
+// LastPlacementStrategy returns the strategy any version of the config was
+// last placed with from this machine and context.
+func LastPlacementStrategy(kind string, reference model.ConfigReference) (model.PlacementStrategy, error) {
+	strategies, _, err := readPlacements()
+	if err != nil {
+		return model.PlacementStrategy{}, err
+	}
+	strategy, ok := strategies[placementKey(kind, reference)]
+	if !ok {
+		return model.PlacementStrategy{}, fmt.Errorf("no placement of %s/%s/%s was recorded: %w", reference.Organization, reference.Namespace, reference.Name, utils.ErrNotFound)
+	}
+	return strategy, nil
+}
+
+func recordPlacement(kind string, request model.PlaceConfigGroupPlacementsRequest) error {
+	strategies, path, err := readPlacements()
+	if err != nil {
+		return err
+	}
+	strategies[placementKey(kind, request.Config)] = request.Strategy
+
+	data, err := yaml.Marshal(strategies)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(path, data, true)
+}
+
+func readPlacements() (map[string]model.PlacementStrategy, string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return nil, "", err
+	}
+	path := filepath.Join(dir, placementsFile)
+
+	strategies := map[string]model.PlacementStrategy{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return strategies, path, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if err := yaml.Unmarshal(data, &strategies); err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return strategies, path, nil
+}
+
+// placementKey identifies a config across its versions within a context.
+func placementKey(kind string, reference model.ConfigReference) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", activeContext.Name, kind, reference.Organization, reference.Namespace, reference.Name)
+}
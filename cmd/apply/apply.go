@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var filenames []string
+
+var ApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: constants.ApplyShortDesc,
+	Long:  constants.ApplyLongDesc,
+	Args:  cobra.NoArgs,
+	Run:   executeApply,
+}
+
+func executeApply(cmd *cobra.Command, args []string) {
+	manifests, err := utils.ReadManifests(filenames, os.Stdin)
+	if err != nil {
+		fmt.Println("Error reading manifests:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	utils.SortManifests(manifests, false)
+
+	results := make([]model.Result, 0, len(manifests))
+	for _, manifest := range manifests {
+		result := model.Result{Kind: utils.ManifestResultKind(manifest), Name: utils.ManifestName(manifest)}
+		status, err := clients.ApplyManifest(context.Background(), manifest)
+		if errors.Is(err, client.ErrDryRun) {
+			status, err = status+model.DryRunSuffix, nil
+		}
+		if err != nil {
+			result.Status = model.StatusFailed
+			results = append(results, result)
+			printResults(results)
+			fmt.Printf("Error applying %s (%s): %v\n", manifest.Source, manifest.Kind, err)
+			if skipped := len(manifests) - len(results); skipped > 0 {
+				fmt.Printf("%d remaining objects were not applied.\n", skipped)
+			}
+			os.Exit(utils.ExitCode(err))
+		}
+		result.Status = status
+		results = append(results, result)
+	}
+	printResults(results)
+}
+
+func printResults(results []model.Result) {
+	if err := render.Print(results); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	ApplyCmd.Flags().StringArrayVarP(&filenames, constants.FilenameFlag, constants.FilenameShorthandFlag, nil, constants.FilenameDescription)
+	ApplyCmd.MarkFlagRequired(constants.FilenameFlag)
+}
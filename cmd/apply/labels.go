@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	labelsPath  string
+	org         string
+	parallelism int
+)
+
+var ApplyLabelsCmd = &cobra.Command{
+	Use:     "labels",
+	Aliases: aliases.LabelsAliases,
+	Short:   constants.ApplyLabelsShortDesc,
+	Long:    constants.ApplyLabelsLongDesc,
+	Run:     executeApplyLabels,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.ValidateRequiredFlags(cmd, []string{constants.FilePathFlag})
+	},
+}
+
+func executeApplyLabels(cmd *cobra.Command, args []string) {
+	manifest, err := utils.ReadLabelsManifest(labelsPath)
+	if err != nil {
+		fmt.Println("Error reading labels:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	if org != "" {
+		manifest.Org = org
+	}
+	if manifest.Org == "" {
+		fmt.Printf("Error: no organization, set org in %s or use --%s\n", labelsPath, constants.OrganizationFlag)
+		os.Exit(constants.ExitCodeValidation)
+	}
+
+	owned, err := clients.Client().ListOrgOwnedNodes(context.Background(), manifest.Org)
+	if err != nil {
+		fmt.Println("Error sending list allocated nodes request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	plan, err := utils.PlanLabelChanges(manifest, owned)
+	if err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	results, applyErr := clients.ApplyLabelChanges(context.Background(), manifest.Org, plan, parallelism)
+	if err := render.Print(results); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	if applyErr != nil {
+		os.Exit(utils.ExitCode(applyErr))
+	}
+}
+
+func init() {
+	ApplyLabelsCmd.Flags().StringVarP(&labelsPath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.LabelsFileDescription)
+	ApplyLabelsCmd.Flags().StringVarP(&org, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.LabelsOrgDescription)
+	ApplyLabelsCmd.Flags().IntVar(&parallelism, constants.ParallelFlag, 4, constants.ParallelDescription)
+
+	ApplyLabelsCmd.MarkFlagRequired(constants.FilePathFlag)
+}
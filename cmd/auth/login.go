@@ -6,14 +6,12 @@ import (
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 	"os"
-	"time"
 )
 
-var tokenResponse model.TokenResponse
-
 var LoginCmd = &cobra.Command{
 	Use:     "login",
 	Aliases: aliases.LoginAliases,
@@ -26,46 +24,23 @@ var LoginCmd = &cobra.Command{
 		password, err := utils.PromptForPassword()
 		if err != nil {
 			fmt.Println("Error:", err)
-			os.Exit(1)
+			os.Exit(utils.ExitCode(err))
 		}
 
-		err = login(username, password)
+		err = clients.Login(username, password)
 		if err != nil {
+			utils.ExitIfDryRun(err)
 			fmt.Println("Error:", err)
-			os.Exit(1)
+			os.Exit(utils.ExitCode(err))
 		}
 
-		fmt.Println("Login successful!")
+		if err := render.Print(model.Result{Kind: "user", Name: username, Status: "logged in"}); err != nil {
+			fmt.Println("Error printing response:", err)
+			os.Exit(utils.ExitCode(err))
+		}
 	},
 }
 
-func login(username, password string) error {
-	credentials := model.Credentials{
-		Username: username,
-		Password: password,
-	}
-
-	url := clients.BuildURL("core", "v1", "LoginUser")
-
-	err := utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: credentials,
-		Response:    &tokenResponse,
-		Timeout:     10 * time.Second,
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to send login request: %v", err)
-	}
-
-	if err := utils.SaveTokenToFile(tokenResponse.Token); err != nil {
-		return fmt.Errorf("failed to save token: %v", err)
-	}
-
-	return nil
-}
-
 func init() {
 	LoginCmd.Flags().StringVarP(&username, constants.UsernameFlag, constants.UsernameShorthandFlag, "", "Username for login")
 	LoginCmd.MarkFlagRequired(constants.UsernameFlag)
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var LogoutCmd = &cobra.Command{
+	Use:     "logout",
+	Aliases: aliases.LogoutAliases,
+	Short:   constants.ShortLogoutDesc,
+	Long:    constants.LongLogoutDesc,
+	Run: func(cmd *cobra.Command, args []string) {
+		user, err := clients.DeleteToken()
+		if err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(utils.ExitCode(err))
+		}
+
+		if err := render.Print(model.Result{Kind: "user", Name: user, Status: "logged out of context " + clients.CurrentContext().Name}); err != nil {
+			fmt.Println("Error printing response:", err)
+			os.Exit(utils.ExitCode(err))
+		}
+	},
+}
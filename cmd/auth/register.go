@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/cheggaaa/pb/v3"
 	"github.com/spf13/cobra"
@@ -33,7 +35,7 @@ var RegisterCmd = &cobra.Command{
 		password, err := utils.PromptForPassword()
 		if err != nil {
 			fmt.Println("Error:", err)
-			os.Exit(1)
+			os.Exit(utils.ExitCode(err))
 		}
 
 		bar := pb.StartNew(100)
@@ -43,11 +45,15 @@ var RegisterCmd = &cobra.Command{
 		bar.Finish()
 
 		if err != nil {
+			utils.ExitIfDryRun(err)
 			fmt.Println("Error:", err)
-			os.Exit(1)
+			os.Exit(utils.ExitCode(err))
 		}
 
-		fmt.Println("Registration successful!")
+		if err := render.Print(model.Result{Kind: "user", Name: username, Status: "registered"}); err != nil {
+			fmt.Println("Error printing response:", err)
+			os.Exit(utils.ExitCode(err))
+		}
 	},
 }
 
@@ -61,8 +67,6 @@ func register(email, name, org, password, surname, username string, bar *pb.Prog
 		Username: username,
 	}
 
-	url := clients.BuildURL("core", "v1", "RegisterUser")
-
 	go func() {
 		for {
 			time.Sleep(50 * time.Millisecond)
@@ -70,13 +74,7 @@ func register(email, name, org, password, surname, username string, bar *pb.Prog
 		}
 	}()
 
-	err := utils.SendHTTPRequestWithProgress(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: registrationDetails,
-		Timeout:     10 * time.Second,
-	}, bar)
-
+	err := clients.Client().RegisterUser(context.Background(), registrationDetails)
 	if err != nil {
 		bar.Finish()
 		return err
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var WhoAmICmd = &cobra.Command{
+	Use:   "whoami",
+	Short: constants.ShortWhoAmIDesc,
+	Long:  constants.LongWhoAmIDesc,
+	Run: func(cmd *cobra.Command, args []string) {
+		whoami, err := clients.WhoAmI()
+		if err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(utils.ExitCode(err))
+		}
+
+		if err := render.Print(whoami); err != nil {
+			fmt.Println("Error printing response:", err)
+			os.Exit(utils.ExitCode(err))
+		}
+	},
+}
@@ -1,23 +1,31 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+
 	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/client"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
 	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
-	"github.com/cheggaaa/pb/v3"
 	"github.com/spf13/cobra"
-	"os"
-	"time"
 )
 
+// nodeIDLabelKey is the key the gateway matches node IDs against in queries.
+const nodeIDLabelKey = "id"
+
 var (
-	org               string
-	query             string
-	claimNodeResponse model.ClaimNodesResponse
+	org      string
+	query    string
+	count    int
+	maxNodes int
+	sortBy   string
+	yes      bool
 )
 
 var ClaimNodesCmd = &cobra.Command{
@@ -26,6 +34,9 @@ var ClaimNodesCmd = &cobra.Command{
 	Short:   constants.ClaimNodesShortDesc,
 	Long:    constants.ClaimNodesLongDesc,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if count < 0 || maxNodes < 0 {
+			return fmt.Errorf("--%s and --%s must not be negative", constants.CountFlag, constants.MaxFlag)
+		}
 		return utils.ValidateRequiredFlags(cmd, []string{constants.OrganizationFlag, constants.QueryFlag})
 	},
 	Run: executeClaimNodes,
@@ -35,74 +46,141 @@ func executeClaimNodes(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareClaimNodesRequest()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	bar := pb.StartNew(100)
-	bar.SetTemplate(pb.Full)
-	bar.SetWidth(50)
-
-	go func() {
-		for {
-			time.Sleep(50 * time.Millisecond)
-			bar.Increment()
-		}
-	}()
+	limit := claimLimit()
+	selected := previewClaim(requestBody, limit)
+	if len(selected) == 0 {
+		printClaimSummary(selected, nil)
+		return
+	}
+	if limit > 0 {
+		claimSelectedNodes(requestBody, selected)
+		return
+	}
 
-	if err := sendClaimNodeRequest(requestBody, bar); err != nil {
-		bar.Finish()
+	nodes, err := clients.Client().ClaimOwnership(context.Background(), requestBody)
+	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error claiming nodes:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	bar.Finish()
-	render.RenderResponseAsTabWriter(claimNodeResponse.Nodes)
-	fmt.Println("These nodes were successfully claimed!")
+	printClaimSummary(selected, nodes)
 }
 
-func prepareClaimNodesRequest() (interface{}, error) {
-	request := model.ClaimNodesRequest{
-		Org: org,
+// claimSelectedNodes claims the nodes picked by --count or --max one by one,
+// adding an id comparison to the query so that no request can claim a node
+// that was not selected.
+func claimSelectedNodes(request model.ClaimNodesRequest, selected []model.Node) {
+	var claimed []model.Node
+	dryRun := false
+	for i, node := range selected {
+		nodeRequest := model.ClaimNodesRequest{
+			Org:   request.Org,
+			Query: append(append([]model.NodeQuery{}, request.Query...), model.NodeQuery{LabelKey: nodeIDLabelKey, ShouldBe: "=", Value: node.ID}),
+		}
+
+		nodes, err := clients.Client().ClaimOwnership(context.Background(), nodeRequest)
+		if errors.Is(err, client.ErrDryRun) {
+			dryRun = true
+			continue
+		}
+		if err != nil {
+			if len(claimed) > 0 {
+				printClaimSummary(selected[:i], claimed)
+			}
+			fmt.Printf("Error claiming node %s: %v\n", node.ID, err)
+			os.Exit(utils.ExitCode(err))
+		}
+		claimed = append(claimed, nodes...)
+	}
+	if dryRun {
+		os.Exit(constants.ExitCodeOK)
 	}
 
-	nodeQueries, err := utils.CreateNodeQuery(query)
-	if err != nil {
-		return nil, err
+	printClaimSummary(selected, claimed)
+}
+
+func printClaimSummary(selected, claimed []model.Node) {
+	if err := render.Print(utils.SummarizeOwnership("claimed", org, selected, claimed)); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
-	request.Query = nodeQueries
+}
 
-	return request, nil
+func claimLimit() int {
+	if count > 0 {
+		return count
+	}
+	return maxNodes
 }
 
-func sendClaimNodeRequest(requestBody interface{}, bar *pb.ProgressBar) error {
-	token, err := utils.ReadTokenFromFile()
+// previewClaim selects the matching nodes of the pool that would be claimed,
+// shows them and asks for confirmation unless --yes is set or it is a dry run.
+func previewClaim(request model.ClaimNodesRequest, limit int) []model.Node {
+	candidates, err := clients.Client().QueryNodePool(context.Background(), request.Query)
 	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
+		fmt.Println("Error sending list nodes request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	if len(candidates) == 0 {
+		return []model.Node{}
+	}
+	if count > 0 && len(candidates) < count {
+		fmt.Printf("Error: only %d nodes match the query, --%s %d requested\n", len(candidates), constants.CountFlag, count)
+		os.Exit(constants.ExitCodeConflict)
 	}
 
-	url := clients.BuildURL("core", "v1", "ClaimOwnership")
+	selected := utils.SelectNodes(candidates, sortBy, limit)
+	if yes || clients.Client().DryRun() {
+		return selected
+	}
 
-	err = utils.SendHTTPRequestWithProgress(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "PATCH",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &claimNodeResponse,
-		Timeout:     10 * time.Second,
-	}, bar)
+	if err := render.PreviewNodes("Nodes to claim", selected, len(candidates)); err != nil {
+		fmt.Println("Error printing preview:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 
+	confirmed, err := utils.Confirm(fmt.Sprintf("Claim %s for %s?", render.NodeCount(len(selected)), org))
 	if err != nil {
-		return err
+		fmt.Printf("Error: %v, use --%s to claim without confirmation\n", err, constants.YesFlag)
+		os.Exit(constants.ExitCodeError)
 	}
+	if !confirmed {
+		fmt.Println("Claim cancelled, no nodes were claimed.")
+		os.Exit(constants.ExitCodeError)
+	}
+	return selected
+}
 
-	bar.SetCurrent(100)
-	return nil
+func prepareClaimNodesRequest() (model.ClaimNodesRequest, error) {
+	request := model.ClaimNodesRequest{
+		Org: org,
+	}
+
+	filter, err := utils.ParseNodeQuery(query)
+	if err != nil {
+		return request, err
+	}
+	if err := filter.RequireServerOnly(query); err != nil {
+		return request, err
+	}
+	request.Query = filter.Server
+
+	return request, nil
 }
 
 func init() {
 	ClaimNodesCmd.Flags().StringVarP(&org, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	ClaimNodesCmd.Flags().StringVarP(&query, constants.QueryFlag, constants.QueryFlagShorthandFlag, "", constants.NodeQueryRequiredDescription)
+	ClaimNodesCmd.Flags().IntVar(&count, constants.CountFlag, 0, constants.ClaimCountDescription)
+	ClaimNodesCmd.Flags().IntVar(&maxNodes, constants.MaxFlag, 0, constants.ClaimMaxDescription)
+	ClaimNodesCmd.Flags().StringVarP(&sortBy, constants.SortByFlag, constants.SortShorthandFlag, "-memory-totalGB", constants.ClaimSortByDescription)
+	ClaimNodesCmd.Flags().BoolVarP(&yes, constants.YesFlag, constants.YesShorthandFlag, false, constants.YesDescription)
 
 	ClaimNodesCmd.MarkFlagRequired(constants.OrganizationFlag)
 	ClaimNodesCmd.MarkFlagRequired(constants.QueryFlag)
+	ClaimNodesCmd.MarkFlagsMutuallyExclusive(constants.CountFlag, constants.MaxFlag)
 }
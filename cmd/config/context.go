@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/config"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+)
+
+func loadClientConfig() (*config.ClientConfig, string) {
+	path, err := config.ClientConfigPath()
+	if err != nil {
+		fmt.Println("Error resolving client config path:", err)
+		os.Exit(1)
+	}
+
+	clientConfig, err := config.LoadClientConfig(path)
+	if err != nil {
+		fmt.Println("Error loading client config:", err)
+		os.Exit(1)
+	}
+
+	return clientConfig, path
+}
+
+func saveClientConfig(clientConfig *config.ClientConfig, path string) {
+	if err := clientConfig.Save(path); err != nil {
+		fmt.Println("Error saving client config:", err)
+		os.Exit(1)
+	}
+}
+
+func printContextResult(name, status string) {
+	if err := render.Print(model.Result{Kind: "context", Name: name, Status: status}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/spf13/cobra"
+)
+
+var DeleteContextCmd = &cobra.Command{
+	Use:   "delete-context <name>",
+	Short: constants.DeleteContextShortDesc,
+	Long:  constants.DeleteContextLongDesc,
+	Args:  cobra.ExactArgs(1),
+	Run:   executeDeleteContext,
+}
+
+func executeDeleteContext(cmd *cobra.Command, args []string) {
+	clientConfig, path := loadClientConfig()
+
+	if !clientConfig.DeleteContext(args[0]) {
+		fmt.Printf("Error: context %q not found\n", args[0])
+		os.Exit(1)
+	}
+
+	saveClientConfig(clientConfig, path)
+
+	printContextResult(args[0], model.StatusDeleted)
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/spf13/cobra"
+)
+
+var GetContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: constants.GetContextsShortDesc,
+	Long:  constants.GetContextsLongDesc,
+	Args:  cobra.NoArgs,
+	Run:   executeGetContexts,
+}
+
+func executeGetContexts(cmd *cobra.Command, args []string) {
+	clientConfig, _ := loadClientConfig()
+	if err := render.Print(*clientConfig); err != nil {
+		fmt.Println("Error printing contexts:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/c12s/cockpit/config"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scheme       string
+	host         string
+	port         string
+	route        string
+	routes       string
+	organization string
+	namespace    string
+	user         string
+	store        string
+	autoRelogin  bool
+	timeout      time.Duration
+	caFile       string
+	clientCert   string
+	clientKey    string
+	insecure     bool
+	proxy        string
+	metricsURL   string
+)
+
+var SetContextCmd = &cobra.Command{
+	Use:   "set-context <name>",
+	Short: constants.SetContextShortDesc,
+	Long:  constants.SetContextLongDesc,
+	Args:  cobra.ExactArgs(1),
+	Run:   executeSetContext,
+}
+
+func executeSetContext(cmd *cobra.Command, args []string) {
+	clientConfig, path := loadClientConfig()
+
+	ctx := config.Context{Name: args[0]}
+	existing, exists := clientConfig.GetContext(args[0])
+	if exists {
+		ctx = *existing
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed(constants.SchemeFlag) {
+		ctx.Gateway.Scheme = scheme
+	}
+	if flags.Changed(constants.HostFlag) {
+		ctx.Gateway.Host = host
+	}
+	if flags.Changed(constants.PortFlag) {
+		ctx.Gateway.Port = port
+	}
+	if flags.Changed(constants.RouteFlag) {
+		ctx.Gateway.Route = route
+	}
+	if flags.Changed(constants.RoutesFlag) {
+		ctx.Routes = routes
+	}
+	if flags.Changed(constants.OrganizationFlag) {
+		ctx.Organization = organization
+	}
+	if flags.Changed(constants.NamespaceFlag) {
+		ctx.Namespace = namespace
+	}
+	if flags.Changed(constants.UserFlag) {
+		ctx.User = user
+	}
+	if flags.Changed(constants.CredentialStoreFlag) {
+		ctx.CredentialStore = store
+	}
+	if flags.Changed(constants.AutoReloginFlag) {
+		ctx.AutoRelogin = autoRelogin
+	}
+	if flags.Changed(constants.TimeoutFlag) {
+		ctx.Timeout = timeout.String()
+	}
+	if flags.Changed(constants.CAFileFlag) {
+		ctx.TLS.CAFile = caFile
+	}
+	if flags.Changed(constants.ClientCertFlag) {
+		ctx.TLS.CertFile = clientCert
+	}
+	if flags.Changed(constants.ClientKeyFlag) {
+		ctx.TLS.KeyFile = clientKey
+	}
+	if flags.Changed(constants.InsecureFlag) {
+		ctx.TLS.InsecureSkipVerify = insecure
+	}
+	if flags.Changed(constants.ProxyFlag) {
+		ctx.Proxy = proxy
+	}
+	if flags.Changed(constants.MetricsURLFlag) {
+		ctx.Metrics = metricsURL
+	}
+
+	clientConfig.SetContext(ctx)
+	if clientConfig.CurrentContext == "" {
+		clientConfig.CurrentContext = ctx.Name
+	}
+	saveClientConfig(clientConfig, path)
+
+	status := model.StatusCreated
+	if exists {
+		status = model.StatusUpdated
+	}
+	printContextResult(ctx.Name, status)
+}
+
+func init() {
+	SetContextCmd.Flags().StringVar(&scheme, constants.SchemeFlag, "", constants.SchemeDescription)
+	SetContextCmd.Flags().StringVar(&host, constants.HostFlag, "", constants.HostDescription)
+	SetContextCmd.Flags().StringVar(&port, constants.PortFlag, "", constants.PortDescription)
+	SetContextCmd.Flags().StringVar(&route, constants.RouteFlag, "", constants.RouteDescription)
+	SetContextCmd.Flags().StringVar(&routes, constants.RoutesFlag, "", constants.RoutesDescription)
+	SetContextCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.DefaultOrganizationDescription)
+	SetContextCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.DefaultNamespaceDescription)
+	SetContextCmd.Flags().StringVar(&user, constants.UserFlag, "", constants.UserDescription)
+	SetContextCmd.Flags().StringVar(&store, constants.CredentialStoreFlag, "", constants.CredentialStoreDescription)
+	SetContextCmd.Flags().BoolVar(&autoRelogin, constants.AutoReloginFlag, false, constants.AutoReloginDescription)
+	SetContextCmd.Flags().DurationVar(&timeout, constants.TimeoutFlag, 0, constants.TimeoutDescription)
+	SetContextCmd.Flags().StringVar(&caFile, constants.CAFileFlag, "", constants.CAFileDescription)
+	SetContextCmd.Flags().StringVar(&clientCert, constants.ClientCertFlag, "", constants.ClientCertDescription)
+	SetContextCmd.Flags().StringVar(&clientKey, constants.ClientKeyFlag, "", constants.ClientKeyDescription)
+	SetContextCmd.Flags().BoolVar(&insecure, constants.InsecureFlag, false, constants.InsecureDescription)
+	SetContextCmd.Flags().StringVar(&proxy, constants.ProxyFlag, "", constants.ProxyDescription)
+	SetContextCmd.Flags().StringVar(&metricsURL, constants.MetricsURLFlag, "", constants.MetricsURLDescription)
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/constants"
+	"github.com/spf13/cobra"
+)
+
+var UseContextCmd = &cobra.Command{
+	Use:   "use-context <name>",
+	Short: constants.UseContextShortDesc,
+	Long:  constants.UseContextLongDesc,
+	Args:  cobra.ExactArgs(1),
+	Run:   executeUseContext,
+}
+
+func executeUseContext(cmd *cobra.Command, args []string) {
+	clientConfig, path := loadClientConfig()
+
+	if _, ok := clientConfig.GetContext(args[0]); !ok {
+		fmt.Printf("Error: context %q not found\n", args[0])
+		os.Exit(1)
+	}
+
+	clientConfig.CurrentContext = args[0]
+	saveClientConfig(clientConfig, path)
+
+	printContextResult(args[0], "in use")
+}
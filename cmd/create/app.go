@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -27,15 +28,19 @@ func executeCreateApp(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareAppRequestBody()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
 	if err := sendCreateAppRequest(requestBody); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("App created successfully")
+	if err := render.Print(model.Result{Kind: "app", Name: utils.DocumentName(requestBody, "orgId", "namespace", "name"), Status: "created"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func prepareAppRequestBody() (map[string]any, error) {
@@ -57,36 +62,12 @@ func prepareAppRequestBody() (map[string]any, error) {
 }
 
 func sendCreateAppRequest(requestBody map[string]any) error {
-	config, err := prepareAppRequestConfig(requestBody)
-	if err != nil {
-		return fmt.Errorf("error creating request config: %v", err)
-	}
-
-	err = utils.SendHTTPRequest(config)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	if err := clients.Client().AddApp(context.Background(), requestBody); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
 	}
-
 	return nil
 }
 
-func prepareAppRequestConfig(requestBody map[string]any) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "AddApp")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: requestBody,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
-}
-
 func init() {
 	CreateAppCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	CreateAppCmd.MarkFlagRequired(constants.FilePathFlag)
@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -27,15 +28,19 @@ func executeCreateNamespace(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareNamespaceRequestBody()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
 	if err := sendCreateNamespaceRequest(requestBody); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("Namespace created successfully")
+	if err := render.Print(model.Result{Kind: "namespace", Name: utils.DocumentName(requestBody, "orgId", "name"), Status: "created"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func prepareNamespaceRequestBody() (map[string]any, error) {
@@ -57,36 +62,12 @@ func prepareNamespaceRequestBody() (map[string]any, error) {
 }
 
 func sendCreateNamespaceRequest(requestBody map[string]any) error {
-	config, err := prepareNamespaceRequestConfig(requestBody)
-	if err != nil {
-		return fmt.Errorf("error creating request config: %v", err)
-	}
-
-	err = utils.SendHTTPRequest(config)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	if err := clients.Client().AddNamespace(context.Background(), requestBody); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
 	}
-
 	return nil
 }
 
-func prepareNamespaceRequestConfig(requestBody map[string]any) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "AddNamespace")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: requestBody,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
-}
-
 func init() {
 	CreateNamespaceCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	CreateNamespaceCmd.MarkFlagRequired(constants.FilePathFlag)
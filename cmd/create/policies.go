@@ -1,16 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 	"os"
 	"strings"
-	"time"
 )
 
 var CreatePoliciesCmd = &cobra.Command{
@@ -28,15 +29,19 @@ func executeCreatePolicies(cmd *cobra.Command, args []string) {
 	requestBody, err := preparePoliciesRequestBody()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
 	if err := sendCreatePoliciesRequest(requestBody); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending policies request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("Policies created successfully")
+	if err := render.Print(requestBody); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func preparePoliciesRequestBody() (model.PoliciesRequest, error) {
@@ -58,36 +63,12 @@ func preparePoliciesRequestBody() (model.PoliciesRequest, error) {
 }
 
 func sendCreatePoliciesRequest(requestBody model.PoliciesRequest) error {
-	config, err := preparePoliciesRequestConfig(requestBody)
-	if err != nil {
-		return fmt.Errorf("error creating request config: %v", err)
-	}
-
-	err = utils.SendHTTPRequest(config)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	if err := clients.Client().CreatePolicy(context.Background(), requestBody); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
 	}
-
 	return nil
 }
 
-func preparePoliciesRequestConfig(requestBody model.PoliciesRequest) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "CreatePolicy")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: requestBody,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
-}
-
 func init() {
 	CreatePoliciesCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	CreatePoliciesCmd.MarkFlagRequired(constants.FilePathFlag)
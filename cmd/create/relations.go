@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -33,7 +34,7 @@ func executeCreateRelations(cmd *cobra.Command, args []string) {
 	idsList, kindsList, err := utils.ParseIDsAndKinds(ids, kinds)
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
 	relation := model.Relation{
@@ -42,44 +43,23 @@ func executeCreateRelations(cmd *cobra.Command, args []string) {
 	}
 
 	if err := sendCreateRelationsRequest(relation); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending relations  request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
+	}
+	if err := render.Print(relation); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
-	fmt.Println("Relations created successfully")
 }
 
 func sendCreateRelationsRequest(relation model.Relation) error {
-	config, err := prepareRelationsRequestConfig(relation)
-	if err != nil {
-		fmt.Printf("Error creating request config: %v\n", err)
-		os.Exit(1)
-	}
-
-	err = utils.SendHTTPRequest(config)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	if err := clients.Client().CreateInheritanceRel(context.Background(), relation); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
 	}
-
 	return nil
 }
 
-func prepareRelationsRequestConfig(relation model.Relation) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "CreateInheritanceRel")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: relation,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
-}
-
 func init() {
 	CreateRelationsCmd.Flags().StringVarP(&ids, constants.IdsFlag, constants.IdsShorthandFlag, "", constants.IdsDescription)
 	CreateRelationsCmd.Flags().StringVarP(&kinds, constants.KindsFlag, constants.KindsShorthandFlag, "", constants.KindsDescription)
@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -36,54 +37,33 @@ func executeCreateSchema(cmd *cobra.Command, args []string) {
 	schema, err := utils.ReadSchemaFile(filePath)
 	if err != nil {
 		fmt.Println("Error reading schema file:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	requestBody := createSchemaRequest(schema)
-	config, err := prepareSchemaRequest(requestBody)
-	if err != nil {
-		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
-	}
-
-	if err := utils.SendHTTPRequest(config); err != nil {
+	request := createSchemaRequest(schema)
+	if err := clients.Client().SaveConfigSchema(context.Background(), request); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending create schema request:", err)
 		fmt.Println()
-		os.Exit(1)
-	}
-
-	fmt.Println("Schema created successfully!")
-}
-
-func createSchemaRequest(schema string) map[string]interface{} {
-	schemaDetails := model.SchemaDetails{
-		Organization: organization,
-		SchemaName:   schemaName,
-		Version:      version,
-		Namespace:    namespace,
+		os.Exit(utils.ExitCode(err))
 	}
 
-	return map[string]interface{}{
-		"schema_details": schemaDetails,
-		"schema":         schema,
+	if err := render.Print(model.Result{Kind: "schema", Name: utils.SchemaName(request.SchemaDetails), Status: "created"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
-func prepareSchemaRequest(requestBody map[string]interface{}) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
+func createSchemaRequest(schema string) model.SaveSchemaRequest {
+	return model.SaveSchemaRequest{
+		SchemaDetails: model.SchemaDetails{
+			Organization: organization,
+			SchemaName:   schemaName,
+			Version:      version,
+			Namespace:    namespace,
+		},
+		Schema: schema,
 	}
-
-	url := clients.BuildURL("core", "v1", "SaveConfigSchema")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: requestBody,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
 }
 
 func init() {
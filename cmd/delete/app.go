@@ -1,13 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -23,45 +24,26 @@ var DeleteAppCmd = &cobra.Command{
 }
 
 func executeDeleteApp(cmd *cobra.Command, args []string) {
-	requestBody := prepareDeleteAppRequest()
-
-	if err := sendDeleteAppRequestConfig(requestBody); err != nil {
+	if err := clients.Client().RemoveApp(context.Background(), prepareDeleteAppRequest()); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending delete app request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("App deleted successfully!")
+	if err := render.Print(model.Result{Kind: "app", Name: organization + "/" + namespace + "/" + name, Status: "deleted"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func prepareDeleteAppRequest() interface{} {
-	return struct {
-		OrgId     string `json:"orgId"`
-		Name      string `json:"name"`
-		Namespace string `json:"namespace"`
-	}{
+func prepareDeleteAppRequest() model.AppReference {
+	return model.AppReference{
 		OrgId:     organization,
 		Name:      name,
 		Namespace: namespace,
 	}
 }
 
-func sendDeleteAppRequestConfig(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "RemoveApp")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		Method:      "DELETE",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-	})
-}
-
 func init() {
 	DeleteAppCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	DeleteAppCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
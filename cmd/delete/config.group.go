@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -16,9 +16,7 @@ import (
 )
 
 var (
-	name                      string
-	outputFormat              string
-	deleteConfigGroupResponse model.ConfigGroup
+	name string
 )
 
 var DeleteConfigGroupCmd = &cobra.Command{
@@ -33,26 +31,20 @@ var DeleteConfigGroupCmd = &cobra.Command{
 }
 
 func executeDeleteConfigGroup(cmd *cobra.Command, args []string) {
-	requestBody := prepareDeleteConfigGroupRequest()
-
-	config := sendDeleteConfigGroupRequest(requestBody)
-
-	err := utils.SendHTTPRequest(config)
+	deleteConfigGroupResponse, err := clients.Client().DeleteConfigGroup(context.Background(), prepareDeleteConfigGroupRequest())
 	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending delete config group request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if outputFormat == "" {
-		render.RenderResponseAsTabWriter(deleteConfigGroupResponse)
-	} else if outputFormat == "yaml" || outputFormat == "json" {
-		render.DisplayResponseAsJSONOrYAML(&deleteConfigGroupResponse, outputFormat, "Config group deleted successfully")
-	} else {
-		println("Invalid output format. Expected 'yaml' or 'json'.")
+	if err := render.Print(deleteConfigGroupResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
-func prepareDeleteConfigGroupRequest() interface{} {
+func prepareDeleteConfigGroupRequest() model.ConfigReference {
 	requestBody := model.ConfigReference{
 		Organization: organization,
 		Namespace:    namespace,
@@ -62,30 +54,10 @@ func prepareDeleteConfigGroupRequest() interface{} {
 	return requestBody
 }
 
-func sendDeleteConfigGroupRequest(requestBody interface{}) model.HTTPRequestConfig {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		fmt.Printf("Error reading token: %v\n", err)
-		os.Exit(1)
-	}
-
-	url := clients.BuildURL("core", "v1", "DeleteConfigGroup")
-
-	return model.HTTPRequestConfig{
-		Method:      "DELETE",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-		Response:    &deleteConfigGroupResponse,
-	}
-}
-
 func init() {
 	DeleteConfigGroupCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	DeleteConfigGroupCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
 	DeleteConfigGroupCmd.Flags().StringVarP(&version, constants.VersionFlag, constants.VersionShorthandFlag, "", constants.VersionDescription)
-	DeleteConfigGroupCmd.Flags().StringVarP(&outputFormat, constants.OutputFlag, constants.OutputShorthandFlag, "", constants.OutputDescription)
 	DeleteConfigGroupCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
 
 	DeleteConfigGroupCmd.MarkFlagRequired(constants.NamespaceFlag)
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	filenames      []string
+	ignoreNotFound bool
+	yes            bool
+)
+
+var DeleteCmd = &cobra.Command{
+	Use:     "delete",
+	Aliases: aliases.DeleteAliases,
+	Short:   constants.DeleteShortDesc,
+	Long:    constants.DeleteLongDesc,
+	Args:    cobra.NoArgs,
+	Run:     executeDeleteManifests,
+}
+
+func executeDeleteManifests(cmd *cobra.Command, args []string) {
+	manifests, err := utils.ReadManifests(filenames, os.Stdin)
+	if err != nil {
+		fmt.Println("Error reading manifests:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	utils.SortManifests(manifests, true)
+
+	results := make([]model.Result, 0, len(manifests))
+	for _, manifest := range manifests {
+		results = append(results, model.Result{Kind: utils.ManifestResultKind(manifest), Name: utils.ManifestName(manifest)})
+	}
+	if !yes && !clients.Client().DryRun() {
+		confirmDelete(results)
+	}
+
+	for i, manifest := range manifests {
+		status, err := clients.DeleteManifest(context.Background(), manifest, ignoreNotFound)
+		if errors.Is(err, client.ErrDryRun) {
+			status, err = status+model.DryRunSuffix, nil
+		}
+		if err != nil {
+			results[i].Status = model.StatusFailed
+			printDeleteResults(results[:i+1])
+			fmt.Printf("Error deleting %s (%s): %v\n", manifest.Source, manifest.Kind, err)
+			if remaining := len(manifests) - i - 1; remaining > 0 {
+				fmt.Printf("%d remaining objects were not deleted.\n", remaining)
+			}
+			os.Exit(utils.ExitCode(err))
+		}
+		results[i].Status = status
+	}
+	printDeleteResults(results)
+}
+
+func confirmDelete(results []model.Result) {
+	if err := render.PreviewResults("Objects to delete", results); err != nil {
+		fmt.Println("Error printing preview:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	confirmed, err := utils.Confirm(fmt.Sprintf("Delete %d objects?", len(results)))
+	if err != nil {
+		fmt.Printf("Error: %v, use --%s to delete without confirmation\n", err, constants.YesFlag)
+		os.Exit(constants.ExitCodeError)
+	}
+	if !confirmed {
+		fmt.Println("Delete cancelled, nothing was deleted.")
+		os.Exit(constants.ExitCodeError)
+	}
+}
+
+func printDeleteResults(results []model.Result) {
+	if err := render.Print(results); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	DeleteCmd.Flags().StringArrayVarP(&filenames, constants.FilenameFlag, constants.FilenameShorthandFlag, nil, constants.FilenameDescription)
+	DeleteCmd.Flags().BoolVar(&ignoreNotFound, constants.IgnoreNotFoundFlag, false, constants.IgnoreNotFoundDescription)
+	DeleteCmd.Flags().BoolVarP(&yes, constants.YesFlag, constants.YesShorthandFlag, false, constants.YesDescription)
+
+	DeleteCmd.MarkFlagRequired(constants.FilenameFlag)
+}
@@ -1,13 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -23,43 +24,25 @@ var DeleteNamespaceCmd = &cobra.Command{
 }
 
 func executeDeleteNamespace(cmd *cobra.Command, args []string) {
-	requestBody := prepareDeleteNamespaceRequest()
-
-	if err := sendDeleteNamespaceRequestConfig(requestBody); err != nil {
+	if err := clients.Client().RemoveNamespace(context.Background(), prepareDeleteNamespaceRequest()); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending delete namespace request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("Namespace deleted successfully!")
+	if err := render.Print(model.Result{Kind: "namespace", Name: organization + "/" + name, Status: "deleted"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func prepareDeleteNamespaceRequest() interface{} {
-	return struct {
-		OrgId string `json:"orgId"`
-		Name  string `json:"name"`
-	}{
+func prepareDeleteNamespaceRequest() model.NamespaceReference {
+	return model.NamespaceReference{
 		OrgId: organization,
 		Name:  name,
 	}
 }
 
-func sendDeleteNamespaceRequestConfig(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "RemoveNamespace")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		Method:      "DELETE",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-	})
-}
-
 func init() {
 	DeleteNamespaceCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	DeleteNamespaceCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
@@ -1,22 +1,22 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 	"os"
-	"time"
 )
 
 var (
-	nodeId       string
-	org          string
-	key          string
-	nodeResponse model.NodeResponse
+	nodeId string
+	org    string
+	key    string
 )
 
 var DeleteNodeLabelsCmd = &cobra.Command{
@@ -31,14 +31,17 @@ var DeleteNodeLabelsCmd = &cobra.Command{
 }
 
 func executeDeleteLabel(cmd *cobra.Command, args []string) {
-	err := sendDeleteLabelRequest()
-
+	node, err := clients.Client().DeleteLabel(context.Background(), prepareLabelRequest())
 	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending delete node label request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Printf("Label %s deleted successfully.\n", key)
+	if err := render.Print(node); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func prepareLabelRequest() model.DeleteLabelInput {
@@ -49,27 +52,6 @@ func prepareLabelRequest() model.DeleteLabelInput {
 	}
 }
 
-func sendDeleteLabelRequest() error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		fmt.Printf("Error reading token: %v\n", err)
-		os.Exit(1)
-	}
-
-	deleteLabelURL := clients.BuildURL("core", "v1", "DeleteLabel")
-
-	input := prepareLabelRequest()
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         deleteLabelURL,
-		Method:      "DELETE",
-		RequestBody: input,
-		Token:       token,
-		Response:    &nodeResponse,
-		Timeout:     10 * time.Second,
-	})
-}
-
 func init() {
 	DeleteNodeLabelsCmd.Flags().StringVarP(&nodeId, constants.NodeIdFlag, constants.NodeIdShorthandFlag, "", constants.NodeIdDescription)
 	DeleteNodeLabelsCmd.Flags().StringVarP(&org, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -32,46 +33,25 @@ var DeleteSchemaCmd = &cobra.Command{
 }
 
 func executeDeleteSchema(cmd *cobra.Command, args []string) {
-	requestBody := prepareDeleteSchemaRequest()
-
-	if err := sendDeleteRequestConfig(requestBody); err != nil {
+	if err := clients.Client().DeleteConfigSchema(context.Background(), prepareDeleteSchemaDetails()); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending delete schema request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("Schema deleted successfully!")
+	if err := render.Print(model.Result{Kind: "schema", Name: utils.SchemaName(prepareDeleteSchemaDetails()), Status: "deleted"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func prepareDeleteSchemaRequest() interface{} {
-	schemaDetails := model.SchemaDetails{
+func prepareDeleteSchemaDetails() model.SchemaDetails {
+	return model.SchemaDetails{
 		Organization: organization,
 		SchemaName:   schemaName,
 		Version:      version,
 		Namespace:    namespace,
 	}
-
-	requestBody := model.SchemaDetailsRequest{
-		SchemaDetails: schemaDetails,
-	}
-
-	return requestBody
-}
-
-func sendDeleteRequestConfig(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "DeleteConfigSchema")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		Method:      "DELETE",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-	})
 }
 
 func init() {
@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -14,10 +14,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	deleteStandaloneConfigResponse model.StandaloneConfig
-)
-
 var DeleteStandaloneConfigCmd = &cobra.Command{
 	Use:     "config",
 	Aliases: aliases.ConfigAliases,
@@ -30,22 +26,16 @@ var DeleteStandaloneConfigCmd = &cobra.Command{
 }
 
 func executeDeleteStandaloneConfig(cmd *cobra.Command, args []string) {
-	requestBody := prepareDeleteStandaloneConfigRequestConfig()
-
-	config := sendDeleteStandaloneConfigRequestConfig(requestBody)
-
-	err := utils.SendHTTPRequest(config)
+	deleteStandaloneConfigResponse, err := clients.Client().DeleteStandaloneConfig(context.Background(), prepareDeleteStandaloneConfigRequestConfig())
 	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending delete standalone config request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if outputFormat == "" {
-		render.RenderResponseAsTabWriter(deleteStandaloneConfigResponse)
-	} else if outputFormat == "yaml" || outputFormat == "json" {
-		render.DisplayResponseAsJSONOrYAML(&deleteStandaloneConfigResponse, outputFormat, "Config group deleted successfully")
-	} else {
-		println("Invalid output format. Expected 'yaml' or 'json'.")
+	if err := render.Print(deleteStandaloneConfigResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
@@ -59,30 +49,10 @@ func prepareDeleteStandaloneConfigRequestConfig() model.SingleConfigReference {
 	return requestBody
 }
 
-func sendDeleteStandaloneConfigRequestConfig(requestBody interface{}) model.HTTPRequestConfig {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		fmt.Printf("Error reading token: %v\n", err)
-		os.Exit(1)
-	}
-
-	url := clients.BuildURL("core", "v1", "DeleteStandaloneConfig")
-
-	return model.HTTPRequestConfig{
-		Method:      "DELETE",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-		Response:    &deleteStandaloneConfigResponse,
-	}
-}
-
 func init() {
 	DeleteStandaloneConfigCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	DeleteStandaloneConfigCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
 	DeleteStandaloneConfigCmd.Flags().StringVarP(&version, constants.VersionFlag, constants.VersionShorthandFlag, "", constants.VersionDescription)
-	DeleteStandaloneConfigCmd.Flags().StringVarP(&outputFormat, constants.OutputFlag, constants.OutputShorthandFlag, "", constants.OutputDescription)
 	DeleteStandaloneConfigCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
 
 	DeleteStandaloneConfigCmd.MarkFlagRequired(constants.NamespaceFlag)
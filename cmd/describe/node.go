@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	nodeID       string
+	organization string
+	namespace    string
+)
+
+var DescribeNodeCmd = &cobra.Command{
+	Use:     "node",
+	Aliases: aliases.NodesAliases,
+	Short:   constants.DescribeNodeShortDesc,
+	Long:    constants.DescribeNodeLongDesc,
+	Run:     executeDescribeNode,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.ValidateRequiredFlags(cmd, []string{constants.NodeIdFlag})
+	},
+}
+
+func executeDescribeNode(cmd *cobra.Command, args []string) {
+	description, err := clients.DescribeNode(context.Background(), nodeID, organization, namespace)
+	if err != nil {
+		fmt.Println("Error describing node:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	if err := render.PrintTables(description, render.NodeDescriptionTables(description)...); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	DescribeNodeCmd.Flags().StringVarP(&nodeID, constants.NodeIdFlag, constants.NodeIdShorthandFlag, "", constants.NodeIdDescription)
+	DescribeNodeCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	DescribeNodeCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
+
+	DescribeNodeCmd.MarkFlagRequired(constants.NodeIdFlag)
+}
@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -20,86 +20,158 @@ var (
 	namespace    string
 	names        string
 	versions     string
-	outputFormat string
-	diffResponse model.ConfigGroupDiffResponse
+	localPath    string
+	against      string
 )
 
 var DiffConfigGroupCmd = &cobra.Command{
-	Use:     "group",
+	Use:     "group [FROM TO]",
 	Aliases: aliases.ConfigAliases,
 	Short:   constants.DiffConfigGroupShortDesc,
 	Long:    constants.DiffConfigGroupLongDesc,
 	Run:     executeDiffConfigGroup,
-	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.ValidateRequiredFlags(cmd, []string{constants.NamespaceFlag, constants.OrganizationFlag, constants.NamesFlag, constants.VersionsFlag})
-	},
+	PreRunE: validateDiffFlags,
 }
 
 func executeDiffConfigGroup(cmd *cobra.Command, args []string) {
-	requestBody, err := utils.PrepareConfigDiffRequest(namespace, names, versions, organization)
+	if localPath != "" {
+		executeLocalDiffConfigGroup()
+		return
+	}
+
+	requestBody, err := prepareDiffRequest(args)
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendDiffRequest(requestBody); err != nil {
+	diffResponse, err := clients.Client().DiffConfigGroup(context.Background(), toConfigGroupDiffRequest(requestBody))
+	if err != nil {
 		fmt.Println("Error sending config group diff request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if outputFormat == "" {
-		render.RenderResponseAsTabWriter(diffResponse)
-	} else if outputFormat == "yaml" || outputFormat == "json" {
-		render.DisplayResponseAsJSONOrYAML(diffResponse, outputFormat, "")
+	from, to := storedSideName(requestBody.Reference), storedSideName(requestBody.Diff)
+	if err := render.PrintConfigGroupDiff(from, to, diffResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
 
-		filePath := constants.DiffConfigFilePathYAML
-		if outputFormat == "json" {
-			filePath = constants.DiffConfigFilePathJSON
-		}
+func executeLocalDiffConfigGroup() {
+	local, err := utils.ReadConfigGroup(localPath)
+	if err != nil {
+		fmt.Println("Error reading config group:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	reference, err := localDiffReference(local.Organization, local.Namespace)
+	if err != nil {
+		fmt.Println("Error preparing request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	stored, err := clients.Client().GetConfigGroup(context.Background(), reference)
+	if err != nil {
+		fmt.Println("Error sending config group request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	diffResponse := utils.DiffConfigGroups(stored, local)
+	from, to := storedSideName(utils.ToSingleConfigReference(reference)), localSideName(local.Organization, local.Namespace, local.Name, local.Version)
+	if err := render.PrintConfigGroupDiff(from, to, diffResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	if len(diffResponse.Diffs) > 0 {
+		os.Exit(constants.ExitCodeDifferences)
+	}
+}
 
-		if err := utils.SaveYAMLOrJSONResponseToFile(&diffResponse, filePath); err != nil {
-			fmt.Println("Failed to save response to file:", err)
-			println()
-			os.Exit(1)
+// validateDiffFlags requires one of two stored versions given as FROM and TO
+// references, two stored versions through --names and --versions, or a
+// --local file with the --against version.
+func validateDiffFlags(cmd *cobra.Command, args []string) error {
+	switch {
+	case len(args) == 1 || len(args) > 2:
+		return &utils.ValidationError{Message: fmt.Sprintf("expected FROM and TO config references, got %d arguments", len(args))}
+	case len(args) == 2:
+		if localPath != "" || names != "" || versions != "" {
+			return &utils.ValidationError{Message: "--local, --names and --versions cannot be used with FROM and TO references"}
+		}
+		return nil
+	case localPath != "":
+		if against == "" {
+			return &utils.ValidationError{Message: "required flag --against cannot be empty with --local"}
 		}
-	} else {
-		println("Invalid output format. Expected 'yaml' or 'json'.")
+		if names != "" || versions != "" {
+			return &utils.ValidationError{Message: "--names and --versions cannot be used with --local"}
+		}
+		return nil
 	}
+	return utils.ValidateRequiredFlags(cmd, []string{constants.NamespaceFlag, constants.OrganizationFlag, constants.NamesFlag, constants.VersionsFlag})
 }
 
-func sendDiffRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
+func prepareDiffRequest(args []string) (model.SingleConfigDiffRequest, error) {
+	if len(args) == 2 {
+		return utils.PrepareConfigReferenceDiffRequest(args[0], args[1], organization, namespace)
 	}
+	return utils.PrepareConfigDiffRequest(namespace, names, versions, organization)
+}
 
-	url := clients.BuildURL("core", "v1", "DiffConfigGroup")
+// localDiffReference resolves --against in the organization and namespace of
+// the local file, falling back to --org and --namespace.
+func localDiffReference(localOrganization, localNamespace string) (model.ConfigReference, error) {
+	defaults := model.ConfigReference{Organization: localOrganization, Namespace: localNamespace}
+	if defaults.Organization == "" {
+		defaults.Organization = organization
+	}
+	if defaults.Namespace == "" {
+		defaults.Namespace = namespace
+	}
+	return utils.ParseConfigReference(against, defaults)
+}
 
-	config := model.HTTPRequestConfig{
-		Method:      "GET",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-		Response:    &diffResponse,
+func storedSideName(reference model.SingleConfigReference) string {
+	return utils.ConfigReferenceName(reference.Organization, reference.Namespace, reference.Name, reference.Version) + " (stored)"
+}
+
+// localSideName names the --local side of a diff after the config it
+// describes, with the organization and namespace defaulting like --against.
+func localSideName(localOrganization, localNamespace, localName, localVersion string) string {
+	if localOrganization == "" {
+		localOrganization = organization
+	}
+	if localNamespace == "" {
+		localNamespace = namespace
+	}
+	if localOrganization == "" || localNamespace == "" || localName == "" || localVersion == "" {
+		return fmt.Sprintf("%s (local)", localPath)
 	}
+	return fmt.Sprintf("%s (local %s)", utils.ConfigReferenceName(localOrganization, localNamespace, localName, localVersion), localPath)
+}
 
-	if err := utils.SendHTTPRequest(config); err != nil {
-		return fmt.Errorf("failed to send HTTP request: %v", err)
+func toConfigGroupDiffRequest(request model.SingleConfigDiffRequest) model.ConfigGroupDiffRequest {
+	return model.ConfigGroupDiffRequest{
+		Reference: toConfigReference(request.Reference),
+		Diff:      toConfigReference(request.Diff),
 	}
+}
 
-	return nil
+func toConfigReference(reference model.SingleConfigReference) model.ConfigReference {
+	return model.ConfigReference{
+		Name:         reference.Name,
+		Namespace:    reference.Namespace,
+		Organization: reference.Organization,
+		Version:      reference.Version,
+	}
 }
 
 func init() {
 	DiffConfigGroupCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	DiffConfigGroupCmd.Flags().StringVarP(&names, constants.NamesFlag, constants.NamesShorthandFlag, "", constants.ConfigDiffNamesDescription)
 	DiffConfigGroupCmd.Flags().StringVarP(&versions, constants.VersionsFlag, constants.VersionsShorthandFlag, "", constants.ConfigDiffVersionsDescription)
-	DiffConfigGroupCmd.Flags().StringVarP(&outputFormat, constants.OutputFlag, constants.OutputShorthandFlag, "", constants.OutputDescription)
 	DiffConfigGroupCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
-
-	DiffConfigGroupCmd.MarkFlagRequired(constants.NamespaceFlag)
-	DiffConfigGroupCmd.MarkFlagRequired(constants.OrganizationFlag)
-	DiffConfigGroupCmd.MarkFlagRequired(constants.NamesFlag)
-	DiffConfigGroupCmd.MarkFlagRequired(constants.VersionsFlag)
+	DiffConfigGroupCmd.Flags().StringVar(&localPath, constants.LocalFlag, "", constants.LocalConfigDescription)
+	DiffConfigGroupCmd.Flags().StringVar(&against, constants.AgainstFlag, "", constants.AgainstDescription)
 }
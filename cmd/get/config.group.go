@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -16,9 +16,7 @@ import (
 )
 
 var (
-	name                string
-	configGroupResponse model.ConfigGroup
-	outputFormat        string
+	name string
 )
 
 var GetSingleConfigGroupCmd = &cobra.Command{
@@ -33,34 +31,19 @@ var GetSingleConfigGroupCmd = &cobra.Command{
 }
 
 func executeGetAppConfig(cmd *cobra.Command, args []string) {
-	requestBody := prepareRequestConfig()
-
-	if err := sendConfigGroupRequest(requestBody); err != nil {
+	configGroupResponse, err := clients.Client().GetConfigGroup(context.Background(), prepareRequestConfig())
+	if err != nil {
 		fmt.Println("Error sending config group request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if outputFormat == "" {
-		render.RenderResponseAsTabWriter(configGroupResponse)
-	} else if outputFormat == "yaml" || outputFormat == "json" {
-		render.DisplayResponseAsJSONOrYAML(&configGroupResponse, outputFormat, "")
-
-		filePath := constants.GetConfigGroupFilePathYAML
-		if outputFormat == "json" {
-			filePath = constants.GetConfigGroupFilePathJSON
-		}
-
-		if err := utils.SaveYAMLOrJSONResponseToFile(&configGroupResponse, filePath); err != nil {
-			fmt.Println("Failed to save response to file:", err)
-			println()
-			os.Exit(1)
-		}
-	} else {
-		println("Invalid output format. Expected 'yaml' or 'json'.")
+	if err := render.Print(configGroupResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
-func prepareRequestConfig() interface{} {
+func prepareRequestConfig() model.ConfigReference {
 	requestBody := model.ConfigReference{
 		Organization: organization,
 		Namespace:    namespace,
@@ -71,29 +54,10 @@ func prepareRequestConfig() interface{} {
 	return requestBody
 }
 
-func sendConfigGroupRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "GetConfigGroup")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &configGroupResponse,
-		Timeout:     10 * time.Second,
-	})
-}
-
 func init() {
 	GetSingleConfigGroupCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	GetSingleConfigGroupCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
 	GetSingleConfigGroupCmd.Flags().StringVarP(&version, constants.VersionFlag, constants.VersionShorthandFlag, "", constants.VersionDescription)
-	GetSingleConfigGroupCmd.Flags().StringVarP(&outputFormat, constants.OutputFlag, constants.OutputShorthandFlag, "", constants.OutputDescription)
 	GetSingleConfigGroupCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
 
 	GetSingleConfigGroupCmd.MarkFlagRequired(constants.NamespaceFlag)
@@ -1,22 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 
 	"github.com/spf13/cobra"
 )
 
-var (
-	response any
-)
-
 var GetNamespaceCmd = &cobra.Command{
 	Use: "namespace",
 	Run: executeGetNamespace,
@@ -26,45 +23,21 @@ var GetNamespaceCmd = &cobra.Command{
 }
 
 func executeGetNamespace(cmd *cobra.Command, args []string) {
-	requestBody := prepareNamespaceRequestConfig()
-
-	if err := sendNamespaceRequest(requestBody); err != nil {
-		fmt.Println("Error sending get namespace request", err)
-		os.Exit(1)
-	}
-
-	if err := utils.SaveYAMLOrJSONResponseToFile(response, constants.ResponseDirPathJSON+"namespace.yaml"); err != nil {
-		fmt.Printf("Failed to save response to YAML file: %v\n", err)
-		os.Exit(1)
-	}
-}
-
-func prepareNamespaceRequestConfig() interface{} {
-	return struct {
-		OrgId string `json:"orgId"`
-		Name  string `json:"name"`
-	}{
+	reference := model.NamespaceReference{
 		OrgId: organization,
 		Name:  name,
 	}
-}
 
-func sendNamespaceRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
+	response, err := clients.Client().GetNamespace(context.Background(), reference)
 	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
+		fmt.Println("Error sending get namespace request", err)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	url := clients.BuildURL("core", "v1", "GetNamespace")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &response,
-		Timeout:     10 * time.Second,
-	})
+	if err := render.Print(response); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func init() {
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var GetNodeCmd = &cobra.Command{
+	Use:     "node <id>",
+	Aliases: aliases.GetNodeAliases,
+	Short:   constants.GetNodeShortDesc,
+	Long:    constants.GetNodeLongDesc,
+	Args:    cobra.ExactArgs(1),
+	Run:     executeGetNode,
+}
+
+func executeGetNode(cmd *cobra.Command, args []string) {
+	node, err := clients.FindNode(context.Background(), args[0], organization)
+	if err != nil {
+		fmt.Println("Error retrieving node:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	if err := render.Print(node); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	GetNodeCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+}
@@ -1,11 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
 	"github.com/c12s/cockpit/render"
@@ -13,9 +14,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-const metricsBaseURL = "http://localhost:8086/api/metrics-api/latest-node-data/"
-const clusterMetricsBaseURL = "http://localhost:8086/api/metrics-api/latest-cluster-data/"
-
 var (
 	nodeID    string
 	clusterID string
@@ -40,48 +38,33 @@ func executeLatestMetrics(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	url := metricsBaseURL + nodeID
 	infraType := "Node"
-	cluster := false
-	if clusterID != "" {
-		url = clusterMetricsBaseURL + clusterID
+	cluster := clusterID != ""
+	if cluster {
 		infraType = "Cluster"
-		cluster = true
 	}
 
-	metricsResponse, err := fetchMetrics(url)
+	metricsResponse, err := fetchMetrics(cluster)
 	if err != nil {
 		fmt.Println("Error fetching metrics:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
-	render.RenderNodeMetrics(metricsResponse, sortBy, infraType)
-
+	tables := []render.Table{render.NodeMetricsTable(metricsResponse, infraType)}
 	if all {
-		fmt.Println()
-		render.RenderServiceMetrics(metricsResponse, sortBy, cluster)
+		tables = append(tables, render.ServiceMetricsTable(metricsResponse, sortBy, cluster))
 	}
-}
 
-func fetchMetrics(url string) (model.MetricResponse, error) {
-	var metricsResponse model.MetricResponse
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return metricsResponse, fmt.Errorf("error reading token: %v", err)
-	}
-
-	config := model.HTTPRequestConfig{
-		URL:      url,
-		Method:   "GET",
-		Token:    token,
-		Response: &metricsResponse,
-		Timeout:  10 * time.Second,
+	if err := render.PrintTables(metricsResponse, tables...); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
+}
 
-	if err := utils.SendHTTPRequest(config); err != nil {
-		return metricsResponse, err
+func fetchMetrics(cluster bool) (model.MetricResponse, error) {
+	if cluster {
+		return clients.Client().LatestClusterMetrics(context.Background(), clusterID)
 	}
-
-	return metricsResponse, nil
+	return clients.Client().LatestNodeMetrics(context.Background(), nodeID)
 }
 
 func init() {
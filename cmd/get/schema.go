@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -16,11 +16,10 @@ import (
 )
 
 var (
-	organization   string
-	schemaName     string
-	version        string
-	namespace      string
-	schemaResponse model.SchemaResponse
+	organization string
+	schemaName   string
+	version      string
+	namespace    string
 )
 
 var GetSchemaCmd = &cobra.Command{
@@ -35,49 +34,25 @@ var GetSchemaCmd = &cobra.Command{
 }
 
 func executeGetSchema(cmd *cobra.Command, args []string) {
-	requestBody := prepareSchemaRequestConfig()
-
-	if err := sendSchemaRequest(requestBody); err != nil {
+	schemaResponse, err := clients.Client().GetConfigSchema(context.Background(), prepareSchemaDetails())
+	if err != nil {
 		fmt.Println("Error sending get schema request", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.DisplayResponseAsJSONOrYAML(schemaResponse.SchemaData, "yaml", "")
-	if err := utils.SaveSchemaResponseToYAML(&schemaResponse, constants.GetSchemaFilePathYAML); err != nil {
-		fmt.Printf("Failed to save response to YAML file: %v\n", err)
-		os.Exit(1)
+	if err := render.Print(schemaResponse.SchemaData); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
-func prepareSchemaRequestConfig() interface{} {
-	requestBody := model.SchemaDetailsRequest{
-		SchemaDetails: model.SchemaDetails{
-			Organization: organization,
-			SchemaName:   schemaName,
-			Version:      version,
-			Namespace:    namespace,
-		},
+func prepareSchemaDetails() model.SchemaDetails {
+	return model.SchemaDetails{
+		Organization: organization,
+		SchemaName:   schemaName,
+		Version:      version,
+		Namespace:    namespace,
 	}
-
-	return requestBody
-}
-
-func sendSchemaRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "GetConfigSchema")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &schemaResponse,
-		Timeout:     10 * time.Second,
-	})
 }
 
 func init() {
@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -15,10 +15,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	schemaVersionResponse model.SchemaVersionResponse
-)
-
 var GetSchemaVersionCmd = &cobra.Command{
 	Use:     "version",
 	Aliases: aliases.VersionAliases,
@@ -31,52 +27,22 @@ var GetSchemaVersionCmd = &cobra.Command{
 }
 
 func executeGetSchemaVersion(cmd *cobra.Command, args []string) {
-	requestBody, err := prepareSchemaVersionRequestConfig()
-	if err != nil {
-		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+	details := model.SchemaDetails{
+		Organization: organization,
+		Namespace:    namespace,
+		SchemaName:   schemaName,
 	}
 
-	if err := sendSchemaVersionRequest(requestBody); err != nil {
+	schemaVersionResponse, err := clients.Client().GetConfigSchemaVersions(context.Background(), details)
+	if err != nil {
 		fmt.Printf("Error retrieving schema versions: %v\n", err)
-		os.Exit(1)
-	}
-
-	render.RenderSchemaVersionsTabWriter(schemaVersionResponse.SchemaVersions)
-	if err := utils.SaveVersionResponseToYAML(&schemaVersionResponse, constants.GetSchemaVersionFilePathYAML); err != nil {
-		fmt.Printf("Failed to save response to YAML file: %v\n", err)
-		os.Exit(1)
-	}
-}
-
-func prepareSchemaVersionRequestConfig() (interface{}, error) {
-	requestBody := model.SchemaDetailsRequest{
-		SchemaDetails: model.SchemaDetails{
-			Organization: organization,
-			Namespace:    namespace,
-			SchemaName:   schemaName,
-		},
+		os.Exit(utils.ExitCode(err))
 	}
 
-	return requestBody, nil
-}
-
-func sendSchemaVersionRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
+	if err := render.Print(schemaVersionResponse.SchemaVersions); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
-
-	url := clients.BuildURL("core", "v1", "GetConfigSchemaVersions")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &schemaVersionResponse,
-		Timeout:     10 * time.Second,
-	})
 }
 
 func init() {
@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -15,10 +15,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	standaloneConfigResponse model.StandaloneConfig
-)
-
 var GetStandaloneConfigCmd = &cobra.Command{
 	Use:     "config",
 	Aliases: aliases.ConfigAliases,
@@ -31,34 +27,19 @@ var GetStandaloneConfigCmd = &cobra.Command{
 }
 
 func executeGetStandaloneConfig(cmd *cobra.Command, args []string) {
-	requestBody := prepareStandaloneRequestConfig()
-
-	if err := sendStandaloneRequest(requestBody); err != nil {
+	standaloneConfigResponse, err := clients.Client().GetStandaloneConfig(context.Background(), prepareStandaloneRequestConfig())
+	if err != nil {
 		fmt.Println("Error sending standalone config request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if outputFormat == "" {
-		render.RenderResponseAsTabWriter(standaloneConfigResponse)
-	} else if outputFormat == "yaml" || outputFormat == "json" {
-		render.DisplayResponseAsJSONOrYAML(&standaloneConfigResponse, outputFormat, "")
-
-		filePath := constants.GetStandaloneConfigFilePathYAML
-		if outputFormat == "json" {
-			filePath = constants.GetStandaloneConfigFilePathJSON
-		}
-
-		if err := utils.SaveYAMLOrJSONResponseToFile(&standaloneConfigResponse, filePath); err != nil {
-			fmt.Println("Failed to save response to file:", err)
-			println()
-			os.Exit(1)
-		}
-	} else {
-		println("Invalid output format. Expected 'yaml' or 'json'.")
+	if err := render.Print(standaloneConfigResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 }
 
-func prepareStandaloneRequestConfig() interface{} {
+func prepareStandaloneRequestConfig() model.ConfigReference {
 	requestBody := model.ConfigReference{
 		Organization: organization,
 		Namespace:    namespace,
@@ -69,29 +50,10 @@ func prepareStandaloneRequestConfig() interface{} {
 	return requestBody
 }
 
-func sendStandaloneRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "GetStandaloneConfig")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &standaloneConfigResponse,
-		Timeout:     10 * time.Second,
-	})
-}
-
 func init() {
 	GetStandaloneConfigCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	GetStandaloneConfigCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
 	GetStandaloneConfigCmd.Flags().StringVarP(&version, constants.VersionFlag, constants.VersionShorthandFlag, "", constants.VersionDescription)
-	GetStandaloneConfigCmd.Flags().StringVarP(&outputFormat, constants.OutputFlag, constants.OutputShorthandFlag, "", constants.OutputDescription)
 	GetStandaloneConfigCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
 
 	GetStandaloneConfigCmd.MarkFlagRequired(constants.NamespaceFlag)
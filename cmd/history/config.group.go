@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	organization string
+	namespace    string
+	name         string
+)
+
+var HistoryConfigGroupCmd = &cobra.Command{
+	Use:     "group",
+	Aliases: aliases.GroupAliases,
+	Short:   constants.HistoryConfigGroupShortDesc,
+	Long:    constants.HistoryConfigGroupLongDesc,
+	Run:     executeHistoryConfigGroup,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.ValidateRequiredFlags(cmd, []string{constants.NamespaceFlag, constants.OrganizationFlag, constants.NameFlag})
+	},
+}
+
+func executeHistoryConfigGroup(cmd *cobra.Command, args []string) {
+	configGroupsResponse, err := clients.Client().ListConfigGroup(context.Background(), organization, namespace)
+	if err != nil {
+		fmt.Println("Error sending config group request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	history := utils.ConfigGroupHistory(configGroupsResponse.Groups, name)
+	if len(history) == 0 {
+		err := fmt.Errorf("config group %s/%s/%s: %w", organization, namespace, name, utils.ErrNotFound)
+		fmt.Println("Error listing config group versions:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	if err := render.PrintTables(history, render.ConfigHistoryTable("config-group", history)); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	HistoryConfigGroupCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	HistoryConfigGroupCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
+	HistoryConfigGroupCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
+
+	HistoryConfigGroupCmd.MarkFlagRequired(constants.OrganizationFlag)
+	HistoryConfigGroupCmd.MarkFlagRequired(constants.NamespaceFlag)
+	HistoryConfigGroupCmd.MarkFlagRequired(constants.NameFlag)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var HistoryStandaloneConfigCmd = &cobra.Command{
+	Use:     "config",
+	Aliases: aliases.ConfigAliases,
+	Short:   constants.HistoryStandaloneConfigShortDesc,
+	Long:    constants.HistoryStandaloneConfigLongDesc,
+	Run:     executeHistoryStandaloneConfig,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.ValidateRequiredFlags(cmd, []string{constants.NamespaceFlag, constants.OrganizationFlag, constants.NameFlag})
+	},
+}
+
+func executeHistoryStandaloneConfig(cmd *cobra.Command, args []string) {
+	standaloneConfigsResponse, err := clients.Client().ListStandaloneConfig(context.Background(), organization, namespace)
+	if err != nil {
+		fmt.Println("Error sending standalone config request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	history := utils.StandaloneConfigHistory(standaloneConfigsResponse.Configurations, name)
+	if len(history) == 0 {
+		err := fmt.Errorf("standalone config %s/%s/%s: %w", organization, namespace, name, utils.ErrNotFound)
+		fmt.Println("Error listing standalone config versions:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	if err := render.PrintTables(history, render.ConfigHistoryTable("standalone-config", history)); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	HistoryStandaloneConfigCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	HistoryStandaloneConfigCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
+	HistoryStandaloneConfigCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
+
+	HistoryStandaloneConfigCmd.MarkFlagRequired(constants.OrganizationFlag)
+	HistoryStandaloneConfigCmd.MarkFlagRequired(constants.NamespaceFlag)
+	HistoryStandaloneConfigCmd.MarkFlagRequired(constants.NameFlag)
+}
@@ -1,15 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
-	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"os"
-	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -26,65 +25,34 @@ var AllocatedNodesCmd = &cobra.Command{
 }
 
 func executeAllocatedNodes(cmd *cobra.Command, args []string) {
-	requestBody, url, err := prepareAllocatedRequest(query)
-	if err != nil {
-		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
-	}
+	filter, selector := parseNodeFilters()
 
-	if err := sendAllocatedNodeRequest(requestBody, url); err != nil {
+	nodes, err := retrieveAllocatedNodes(filter)
+	if err != nil {
 		fmt.Println("Error sending list allocated nodes request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if details {
-		render.RenderNodes(nodesResponse.Nodes)
-	} else {
-		render.RenderNodesTabWriter(nodesResponse.Nodes)
-	}
+	printNodes(selector.Filter(nodes))
 }
 
-func prepareAllocatedRequest(query string) (interface{}, string, error) {
-	var request model.ClaimNodesRequest
-	var allocatedNodesURL string
-
-	if query == "" {
-		allocatedNodesURL = clients.BuildURL("core", "v1", "ListOrgOwnedNodes")
-		request.Org = org
+func retrieveAllocatedNodes(filter *utils.NodeFilter) ([]model.Node, error) {
+	var nodes []model.Node
+	var err error
+	if len(filter.Server) == 0 {
+		nodes, err = clients.Client().ListOrgOwnedNodes(context.Background(), org)
 	} else {
-		allocatedNodesURL = clients.BuildURL("core", "v1", "QueryOrgOwnedNodes")
-		nodeQueries, err := utils.CreateNodeQuery(query)
-		if err != nil {
-			return nil, "", err
-		}
-		request = model.ClaimNodesRequest{
-			Org:   org,
-			Query: nodeQueries,
-		}
+		nodes, err = clients.Client().QueryOrgOwnedNodes(context.Background(), org, filter.Server)
 	}
-	return request, allocatedNodesURL, nil
-}
-
-func sendAllocatedNodeRequest(requestBody interface{}, url string) error {
-	token, err := utils.ReadTokenFromFile()
 	if err != nil {
-		return fmt.Errorf("Error reading token: %v", err)
+		return nil, err
 	}
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		Headers:     map[string]string{"Content-Type": "application/json"},
-		RequestBody: requestBody,
-		Response:    &nodesResponse,
-		Timeout:     10 * time.Second,
-	})
+	return filter.Filter(nodes), nil
 }
 
 func init() {
 	AllocatedNodesCmd.Flags().StringVarP(&org, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
-	AllocatedNodesCmd.Flags().StringVarP(&query, constants.QueryFlag, constants.QueryFlagShorthandFlag, "", constants.NodeQueryDescription)
-	AllocatedNodesCmd.Flags().BoolVarP(&details, "details", "d", false, "Display detailed node information")
+	addNodeListFlags(AllocatedNodesCmd)
 
 	AllocatedNodesCmd.MarkFlagRequired(constants.OrganizationFlag)
 }
@@ -1,9 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -16,9 +16,8 @@ import (
 )
 
 var (
-	name                          string
-	version                       string
-	groupConfigPlacementsResponse model.ConfigGroupPlacementsResponse
+	name    string
+	version string
 )
 
 var ListConfigGroupPlacementsCmd = &cobra.Command{
@@ -33,43 +32,25 @@ var ListConfigGroupPlacementsCmd = &cobra.Command{
 }
 
 func executeListConfigGroupPlacements(cmd *cobra.Command, args []string) {
-	requestBody := preparePlacementsRequestConfig()
-
-	if err := sendPlacementsRequest(requestBody); err != nil {
+	tasks, err := clients.Client().ListPlacementTaskByConfigGroup(context.Background(), preparePlacementsReference())
+	if err != nil {
 		fmt.Println("Error sending config group placements request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.RenderResponseAsTabWriter(groupConfigPlacementsResponse.Tasks)
+	if err := render.Print(tasks); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func preparePlacementsRequestConfig() interface{} {
-	requestBody := model.ConfigReference{
+func preparePlacementsReference() model.ConfigReference {
+	return model.ConfigReference{
 		Name:         name,
 		Namespace:    namespace,
 		Organization: organization,
 		Version:      version,
 	}
-
-	return requestBody
-}
-
-func sendPlacementsRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "ListPlacementTaskByConfigGroup")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &groupConfigPlacementsResponse,
-		Timeout:     10 * time.Second,
-	})
 }
 
 func init() {
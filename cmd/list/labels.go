@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var labelsNodeID string
+
+var ListLabelsCmd = &cobra.Command{
+	Use:     "labels",
+	Aliases: aliases.LabelsAliases,
+	Short:   constants.ListLabelsShortDesc,
+	Long:    constants.ListLabelsLongDesc,
+	Run:     executeListLabels,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.ValidateRequiredFlags(cmd, []string{constants.NodeIdFlag})
+	},
+}
+
+func executeListLabels(cmd *cobra.Command, args []string) {
+	node, err := clients.FindNode(context.Background(), labelsNodeID, organization)
+	if err != nil {
+		fmt.Println("Error retrieving node:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	labels := model.NodeLabels{NodeID: node.ID, Org: node.Org, Labels: []model.TypedLabel{}}
+	for _, label := range node.Labels {
+		labels.Labels = append(labels.Labels, model.TypedLabel{
+			Key:   label.Key,
+			Type:  utils.LabelValueType(label.Value),
+			Value: label.Value,
+		})
+	}
+
+	if err := render.Print(labels); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	ListLabelsCmd.Flags().StringVarP(&labelsNodeID, constants.NodeIdFlag, constants.NodeIdShorthandFlag, "", constants.NodeIdDescription)
+	ListLabelsCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.ListLabelsOrgDescription)
+
+	ListLabelsCmd.MarkFlagRequired(constants.NodeIdFlag)
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -9,7 +10,6 @@ import (
 	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"os"
-	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -18,7 +18,10 @@ var (
 	query         string
 	org           string
 	details       bool
-	nodesResponse model.NodesResponse
+	columns       []string
+	sortBy        string
+	labelSelector string
+	limit         int
 )
 
 var NodesCmd = &cobra.Command{
@@ -30,50 +33,92 @@ var NodesCmd = &cobra.Command{
 }
 
 func executeRetrieveNodes(cmd *cobra.Command, args []string) {
-	requestBody, url, err := prepareRequest(query)
+	filter, selector := parseNodeFilters()
+
+	nodes, err := retrieveNodes(filter)
 	if err != nil {
-		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		fmt.Println("Error sending list nodes request:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendNodeRequest(requestBody, url); err != nil {
-		fmt.Println("Error sending list nodes request:", err)
-		os.Exit(1)
+	printNodes(selector.Filter(nodes))
+}
+
+func parseNodeFilters() (*utils.NodeFilter, *utils.LabelSelector) {
+	if limit < 0 {
+		fmt.Printf("Error: --%s must not be negative\n", constants.LimitFlag)
+		os.Exit(constants.ExitCodeValidation)
 	}
 
-	if details {
-		render.RenderNodes(nodesResponse.Nodes)
-	} else {
-		render.RenderNodesTabWriter(nodesResponse.Nodes)
+	filter, err := utils.ParseNodeQuery(query)
+	if err != nil {
+		fmt.Println("Error parsing query:", err)
+		os.Exit(utils.ExitCode(err))
 	}
+
+	selector, err := utils.ParseLabelSelector(labelSelector)
+	if err != nil {
+		fmt.Println("Error parsing label selector:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	return filter, selector
 }
 
-func prepareRequest(query string) (interface{}, string, error) {
-	if query == "" {
-		return nil, clients.BuildURL("core", "v1", "ListNodePool"), nil
+// printNodes sorts and limits the matching nodes before printing them.
+func printNodes(nodes []model.Node) {
+	matching := len(nodes)
+	if sortBy != "" {
+		utils.SortNodes(nodes, sortBy)
+	}
+	if limit > 0 && len(nodes) > limit {
+		nodes = nodes[:limit]
+	}
+
+	if details && render.TableOutput() {
+		render.RenderNodes(nodes)
+		if err := render.SaveResponse(nodes); err != nil {
+			fmt.Println("Error saving response:", err)
+			os.Exit(utils.ExitCode(err))
+		}
+		return
 	}
 
-	nodeQueries, err := utils.CreateNodeQuery(query)
-	if err != nil {
-		return nil, "", err
+	table := render.NodesTable(nodes)
+	if len(columns) > 0 {
+		table = render.NodeColumnsTable(nodes, columns)
+	}
+	table.Footer = render.NodesFooter(nodes, matching)
+
+	if err := render.PrintTables(nodes, table); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
-	requestBody := map[string][]model.NodeQuery{"query": nodeQueries}
-	url := clients.BuildURL("core", "v1", "QueryNodePool")
-	return requestBody, url, nil
 }
 
-func sendNodeRequest(requestBody interface{}, url string) error {
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Headers:     map[string]string{"Content-Type": "application/json"},
-		RequestBody: requestBody,
-		Response:    &nodesResponse,
-		Timeout:     10 * time.Second,
-	})
+// addNodeListFlags registers the flags shared by the node list commands.
+func addNodeListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&query, constants.QueryFlag, constants.QueryFlagShorthandFlag, "", constants.NodeQueryDescription)
+	cmd.Flags().BoolVarP(&details, "details", "d", false, "Display detailed node information")
+	cmd.Flags().StringSliceVarP(&columns, constants.ColumnsFlag, constants.ColumnsShorthandFlag, nil, constants.NodeColumnsDescription)
+	cmd.Flags().StringVarP(&sortBy, constants.SortByFlag, constants.SortShorthandFlag, "", constants.NodeSortByDescription)
+	cmd.Flags().StringVarP(&labelSelector, constants.LabelSelectorFlag, constants.LabelSelectorShorthand, "", constants.LabelSelectorDescription)
+	cmd.Flags().IntVar(&limit, constants.LimitFlag, 0, constants.LimitDescription)
+}
+
+func retrieveNodes(filter *utils.NodeFilter) ([]model.Node, error) {
+	var nodes []model.Node
+	var err error
+	if len(filter.Server) == 0 {
+		nodes, err = clients.Client().ListNodePool(context.Background())
+	} else {
+		nodes, err = clients.Client().QueryNodePool(context.Background(), filter.Server)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return filter.Filter(nodes), nil
 }
 
 func init() {
-	NodesCmd.Flags().StringVarP(&query, constants.QueryFlag, constants.QueryFlagShorthandFlag, "", constants.NodeQueryDescription)
-	NodesCmd.Flags().BoolVarP(&details, "details", "d", false, "Display detailed node information")
+	addNodeListFlags(NodesCmd)
 }
@@ -1,24 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
-	"github.com/c12s/cockpit/model"
 	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 
 	"github.com/spf13/cobra"
 )
 
-var (
-	standaloneConfigPlacementsResponse model.ConfigGroupPlacementsResponse
-)
-
 var ListStandaloneConfigPlacementsCmd = &cobra.Command{
 	Use:     "placements",
 	Aliases: aliases.PlacementAliases,
@@ -31,43 +26,16 @@ var ListStandaloneConfigPlacementsCmd = &cobra.Command{
 }
 
 func executeListStandaloneConfigPlacements(cmd *cobra.Command, args []string) {
-	requestBody := prepareStandalonePlacementsRequestConfig()
-
-	if err := sendStandaloneConfigPlacementsRequest(requestBody); err != nil {
+	tasks, err := clients.Client().ListPlacementTaskByStandaloneConfig(context.Background(), preparePlacementsReference())
+	if err != nil {
 		fmt.Println("Error sending standalone config request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.RenderResponseAsTabWriter(standaloneConfigPlacementsResponse.Tasks)
-}
-
-func prepareStandalonePlacementsRequestConfig() interface{} {
-	requestBody := model.ConfigReference{
-		Name:         name,
-		Namespace:    namespace,
-		Organization: organization,
-		Version:      version,
+	if err := render.Print(tasks); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
-
-	return requestBody
-}
-
-func sendStandaloneConfigPlacementsRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "ListPlacementTaskByStandaloneConfig")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "GET",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &standaloneConfigPlacementsResponse,
-		Timeout:     10 * time.Second,
-	})
 }
 
 func init() {
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -10,14 +11,12 @@ import (
 	"github.com/c12s/cockpit/utils"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
 )
 
 var (
-	path                          string
-	groupConfigPlacementsResponse model.ConfigGroupPlacementsResponse
+	path string
 )
 
 var PlaceConfigGroupPlacementsCmd = &cobra.Command{
@@ -35,18 +34,23 @@ func executePlaceConfigGroupPlacements(cmd *cobra.Command, args []string) {
 	requestBody, err := preparePlacementsRequestConfig()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendPlacementsRequest(requestBody); err != nil {
+	tasks, err := clients.PlaceConfig(context.Background(), model.KindConfigGroupPlacement, requestBody)
+	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending config group placements request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.RenderResponseAsTabWriter(groupConfigPlacementsResponse.Tasks)
+	if err := render.Print(tasks); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func preparePlacementsRequestConfig() (interface{}, error) {
+func preparePlacementsRequestConfig() (model.PlaceConfigGroupPlacementsRequest, error) {
 	var requestBody model.PlaceConfigGroupPlacementsRequest
 	var err error
 	if strings.HasSuffix(path, ".yaml") {
@@ -54,34 +58,16 @@ func preparePlacementsRequestConfig() (interface{}, error) {
 	} else if strings.HasSuffix(path, ".json") {
 		err = utils.ReadJSON(path, &requestBody)
 	} else {
-		return nil, fmt.Errorf("invalid file format. Please provide a YAML or JSON file")
+		return requestBody, fmt.Errorf("invalid file format. Please provide a YAML or JSON file")
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to read input file: %v", err)
+		return requestBody, fmt.Errorf("failed to read input file: %v", err)
 	}
 
 	return requestBody, nil
 }
 
-func sendPlacementsRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "PlaceConfigGroup")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &groupConfigPlacementsResponse,
-		Timeout:     10 * time.Second,
-	})
-}
-
 func init() {
 	PlaceConfigGroupPlacementsCmd.Flags().StringVarP(&path, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	PlaceConfigGroupPlacementsCmd.MarkFlagRequired(constants.FilePathFlag)
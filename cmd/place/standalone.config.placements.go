@@ -1,10 +1,10 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -16,10 +16,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var (
-	standaloneConfigPlacementsResponse model.ConfigGroupPlacementsResponse
-)
-
 var PlaceStandaloneConfigPlacementsCmd = &cobra.Command{
 	Use:     "config",
 	Aliases: aliases.ConfigAliases,
@@ -35,18 +31,23 @@ func executePlaceStandaloneConfigPlacements(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareStandaloneConfigPlacementsRequestConfig()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendStandaloneConfigPlacementsRequest(requestBody); err != nil {
+	tasks, err := clients.PlaceConfig(context.Background(), model.KindStandaloneConfigPlacement, requestBody)
+	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending standalone configuration request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.RenderResponseAsTabWriter(standaloneConfigPlacementsResponse.Tasks)
+	if err := render.Print(tasks); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func prepareStandaloneConfigPlacementsRequestConfig() (interface{}, error) {
+func prepareStandaloneConfigPlacementsRequestConfig() (model.PlaceConfigGroupPlacementsRequest, error) {
 	var requestBody model.PlaceConfigGroupPlacementsRequest
 	var err error
 	if strings.HasSuffix(path, ".yaml") {
@@ -54,34 +55,16 @@ func prepareStandaloneConfigPlacementsRequestConfig() (interface{}, error) {
 	} else if strings.HasSuffix(path, ".json") {
 		err = utils.ReadJSON(path, &requestBody)
 	} else {
-		return nil, fmt.Errorf("invalid file format. Please provide a YAML or JSON file")
+		return requestBody, fmt.Errorf("invalid file format. Please provide a YAML or JSON file")
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to read input file: %v", err)
+		return requestBody, fmt.Errorf("failed to read input file: %v", err)
 	}
 
 	return requestBody, nil
 }
 
-func sendStandaloneConfigPlacementsRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "PlaceStandaloneConfig")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		Token:       token,
-		RequestBody: requestBody,
-		Response:    &standaloneConfigPlacementsResponse,
-		Timeout:     10 * time.Second,
-	})
-}
-
 func init() {
 	PlaceStandaloneConfigPlacementsCmd.Flags().StringVarP(&path, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	PlaceStandaloneConfigPlacementsCmd.MarkFlagRequired(constants.FilePathFlag)
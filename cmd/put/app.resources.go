@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -25,15 +26,19 @@ func executePutAppResources(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareAppRequestBody()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
 	if err := sendPutAppResourcesRequest(requestBody); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("App resources updated successfully")
+	if err := render.Print(model.Result{Kind: "app", Name: utils.DocumentName(requestBody, "orgId", "namespace", "name"), Status: "resources updated"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func prepareAppRequestBody() (map[string]any, error) {
@@ -55,36 +60,12 @@ func prepareAppRequestBody() (map[string]any, error) {
 }
 
 func sendPutAppResourcesRequest(requestBody map[string]any) error {
-	config, err := prepareAppRequestConfig(requestBody)
-	if err != nil {
-		return fmt.Errorf("error creating request config: %v", err)
-	}
-
-	err = utils.SendHTTPRequest(config)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	if err := clients.Client().SetAppResources(context.Background(), requestBody); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
 	}
-
 	return nil
 }
 
-func prepareAppRequestConfig(requestBody map[string]any) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "SetAppResources")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: requestBody,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
-}
-
 func init() {
 	PutAppResourcesCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	PutAppResourcesCmd.MarkFlagRequired(constants.FilePathFlag)
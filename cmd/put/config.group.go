@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -9,14 +10,17 @@ import (
 	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"os"
-	"time"
 
 	"github.com/spf13/cobra"
 )
 
 var (
-	filePath               string
-	configGroupPutResponse model.ConfigGroup
+	filePath      string
+	bump          string
+	allowExisting bool
+	overlays      []string
+	valuesFiles   []string
+	sets          []string
 )
 
 var PutConfigGroupCmd = &cobra.Command{
@@ -25,45 +29,58 @@ var PutConfigGroupCmd = &cobra.Command{
 	Short:   constants.PutConfigGroupShortDesc,
 	Long:    constants.PutConfigGroupLongDesc,
 	Run:     executePutConfigGroup,
-	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.ValidateRequiredFlags(cmd, []string{constants.FilePathFlag})
-	},
+	PreRunE: validatePutConfigFlags,
 }
 
 func executePutConfigGroup(cmd *cobra.Command, args []string) {
-	configData, err := utils.PrepareRequestBodyFromYAMLOrJSON(filePath)
+	configData, err := utils.BuildConfigDocument(configSources(model.KindConfigGroup))
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendConfigGroupData(configData); err != nil {
+	if err := clients.PrepareConfigGroupVersion(context.Background(), configData, bump, allowExisting); err != nil {
+		fmt.Println("Error preparing version:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	configGroupPutResponse, err := clients.Client().PutConfigGroup(context.Background(), configData)
+	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending config group request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.RenderResponseAsTabWriter(configGroupPutResponse)
+	if err := render.Print(configGroupPutResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func sendConfigGroupData(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
+func validatePutConfigFlags(cmd *cobra.Command, args []string) error {
+	if err := utils.ValidateRequiredFlags(cmd, []string{constants.FilePathFlag}); err != nil {
+		return err
+	}
+	if bump != "" {
+		return utils.ValidateBump(bump)
 	}
+	return nil
+}
 
-	url := clients.BuildURL("core", "v1", "PutConfigGroup")
+func configSources(kind string) utils.ConfigSources {
+	return utils.ConfigSources{Kind: kind, Path: filePath, Overlays: overlays, Values: valuesFiles, Sets: sets}
+}
 
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		Method:      "POST",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-		Response:    &configGroupPutResponse,
-	})
+func addConfigSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&overlays, constants.OverlayFlag, nil, constants.OverlayDescription)
+	cmd.Flags().StringArrayVar(&valuesFiles, constants.ValuesFlag, nil, constants.ValuesDescription)
+	cmd.Flags().StringArrayVar(&sets, constants.SetFlag, nil, constants.SetDescription)
 }
 
 func init() {
 	PutConfigGroupCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
+	PutConfigGroupCmd.Flags().StringVar(&bump, constants.BumpFlag, "", constants.BumpDescription)
+	PutConfigGroupCmd.Flags().BoolVar(&allowExisting, constants.AllowExistingFlag, false, constants.AllowExistingDescription)
+	addConfigSourceFlags(PutConfigGroupCmd)
 	PutConfigGroupCmd.MarkFlagRequired(constants.FilePathFlag)
 }
@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 )
@@ -25,15 +26,19 @@ func executePutNamespaceResources(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareNamespaceRequestBody()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
 	if err := sendPutNamespaceResourcesRequest(requestBody); err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("App resources updated successfully")
+	if err := render.Print(model.Result{Kind: "namespace", Name: utils.DocumentName(requestBody, "orgId", "name"), Status: "resources updated"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func prepareNamespaceRequestBody() (map[string]any, error) {
@@ -55,36 +60,12 @@ func prepareNamespaceRequestBody() (map[string]any, error) {
 }
 
 func sendPutNamespaceResourcesRequest(requestBody map[string]any) error {
-	config, err := prepareNamespaceRequestConfig(requestBody)
-	if err != nil {
-		return fmt.Errorf("error creating request config: %v", err)
-	}
-
-	err = utils.SendHTTPRequest(config)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	if err := clients.Client().SetNamespaceResources(context.Background(), requestBody); err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
 	}
-
 	return nil
 }
 
-func prepareNamespaceRequestConfig(requestBody map[string]any) (model.HTTPRequestConfig, error) {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return model.HTTPRequestConfig{}, fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "SetNamespaceResources")
-
-	return model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: requestBody,
-		Token:       token,
-		Timeout:     10 * time.Second,
-	}, nil
-}
-
 func init() {
 	PutNamespaceResourcesCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
 	PutNamespaceResourcesCmd.MarkFlagRequired(constants.FilePathFlag)
@@ -1,24 +1,24 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"github.com/spf13/cobra"
 	"os"
-	"strconv"
-	"time"
 )
 
 var (
-	nodeId       string
-	org          string
-	key          string
-	value        string
-	nodeResponse model.NodeResponse
+	nodeId    string
+	org       string
+	key       string
+	value     string
+	valueType string
 )
 
 var LabelsCmd = &cobra.Command{
@@ -28,65 +28,44 @@ var LabelsCmd = &cobra.Command{
 	Long:    constants.LongLabelDesc,
 	Run:     executeLabelCommand,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.ValidateRequiredFlags(cmd, []string{constants.KeyFlag, constants.ValueFlag, constants.NodeIdFlag, constants.OrganizationFlag})
+		if err := utils.ValidateRequiredFlags(cmd, []string{constants.KeyFlag, constants.ValueFlag, constants.NodeIdFlag, constants.OrganizationFlag}); err != nil {
+			return err
+		}
+		return utils.ValidateLabelKey(key)
 	},
 }
 
 func executeLabelCommand(cmd *cobra.Command, args []string) {
-	originalValue := value
-
-	formattedValue, url, err := determineValueTypeAndURL(value)
+	label, err := createTypedLabel(key, value, valueType)
 	if err != nil {
-		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		fmt.Println("Error:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	labelInput := createLabelInput(key, formattedValue, nodeId, org)
-	err = sendLabelRequest(labelInput, url)
+	node, err := clients.PutTypedLabel(context.Background(), org, nodeId, label)
 	if err != nil {
+		utils.ExitIfDryRun(err)
 		fmt.Println("Error sending add node label request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Printf("Label %s with value %s: added or updated successfully.\n", key, originalValue)
-}
-
-func sendLabelRequest(input model.LabelInput, url string) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		fmt.Printf("Error reading token: %v\n", err)
-		os.Exit(1)
+	if err := render.Print(node); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
 	}
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		URL:         url,
-		Method:      "POST",
-		RequestBody: input,
-		Token:       token,
-		Response:    &nodeResponse,
-		Timeout:     10 * time.Second,
-	})
 }
 
-func determineValueTypeAndURL(valueStr string) (interface{}, string, error) {
-	if floatValue, err := strconv.ParseFloat(valueStr, 64); err == nil {
-		return floatValue, clients.BuildURL("core", "v1", "PutFloat64Label"), nil
+// createTypedLabel converts the value to the given type, or to the type
+// inferred from the value when none is given.
+func createTypedLabel(key, value, valueType string) (model.TypedLabel, error) {
+	if valueType == "" {
+		valueType = utils.InferLabelType(value)
 	}
-	if boolValue, err := strconv.ParseBool(valueStr); err == nil {
-		return boolValue, clients.BuildURL("core", "v1", "PutBoolLabel"), nil
-	}
-	return valueStr, clients.BuildURL("core", "v1", "PutStringLabel"), nil
-}
-
-func createLabelInput(key string, value interface{}, nodeId string, org string) model.LabelInput {
-	return model.LabelInput{
-		Label: model.Label{
-			Key:   key,
-			Value: value,
-		},
-		NodeID: nodeId,
-		Org:    org,
+	typedValue, err := utils.TypedLabelValue(valueType, value)
+	if err != nil {
+		return model.TypedLabel{}, err
 	}
+	return model.TypedLabel{Key: key, Type: valueType, Value: typedValue}, nil
 }
 
 func init() {
@@ -94,6 +73,7 @@ func init() {
 	LabelsCmd.Flags().StringVarP(&value, constants.ValueFlag, constants.ValueShorthandFlag, "", constants.LabelValueDescription)
 	LabelsCmd.Flags().StringVarP(&nodeId, constants.NodeIdFlag, constants.NodeIdShorthandFlag, "", constants.NodeIdDescription)
 	LabelsCmd.Flags().StringVarP(&org, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	LabelsCmd.Flags().StringVar(&valueType, constants.TypeFlag, "", constants.LabelTypeDescription)
 
 	LabelsCmd.MarkFlagRequired(constants.KeyFlag)
 	LabelsCmd.MarkFlagRequired(constants.ValueFlag)
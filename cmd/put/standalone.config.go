@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
@@ -9,59 +10,48 @@ import (
 	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 	"os"
-	"time"
 
 	"github.com/spf13/cobra"
 )
 
-var (
-	standaloneConfigPutResponse model.StandaloneConfig
-)
 var PutStandaloneConfigCmd = &cobra.Command{
 	Use:     "config",
 	Aliases: aliases.ConfigAliases,
 	Short:   constants.PutStandaloneConfigShortDesc,
 	Long:    constants.PutStandaloneConfigLongDesc,
 	Run:     executePutStandaloneConfig,
-	PreRunE: func(cmd *cobra.Command, args []string) error {
-		return utils.ValidateRequiredFlags(cmd, []string{constants.FilePathFlag})
-	},
+	PreRunE: validatePutConfigFlags,
 }
 
 func executePutStandaloneConfig(cmd *cobra.Command, args []string) {
-	configData, err := utils.PrepareRequestBodyFromYAMLOrJSON(filePath)
+	configData, err := utils.BuildConfigDocument(configSources(model.KindStandaloneConfig))
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendStandaloneConfigData(configData); err != nil {
-		fmt.Println("Error sending standalone config request:", err)
-		os.Exit(1)
+	if err := clients.PrepareStandaloneConfigVersion(context.Background(), configData, bump, allowExisting); err != nil {
+		fmt.Println("Error preparing version:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	render.RenderResponseAsTabWriter(standaloneConfigPutResponse)
-}
-
-func sendStandaloneConfigData(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
+	standaloneConfigPutResponse, err := clients.Client().PutStandaloneConfig(context.Background(), configData)
 	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
+		utils.ExitIfDryRun(err)
+		fmt.Println("Error sending standalone config request:", err)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	url := clients.BuildURL("core", "v1", "PutStandaloneConfig")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		Method:      "POST",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-		Response:    &standaloneConfigPutResponse,
-	})
+	if err := render.Print(standaloneConfigPutResponse); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
 func init() {
 	PutStandaloneConfigCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
+	PutStandaloneConfigCmd.Flags().StringVar(&bump, constants.BumpFlag, "", constants.BumpDescription)
+	PutStandaloneConfigCmd.Flags().BoolVar(&allowExisting, constants.AllowExistingFlag, false, constants.AllowExistingDescription)
+	addConfigSourceFlags(PutStandaloneConfigCmd)
 	PutStandaloneConfigCmd.MarkFlagRequired(constants.FilePathFlag)
 }
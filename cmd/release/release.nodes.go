@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	org     string
+	query   string
+	nodeIDs []string
+	yes     bool
+)
+
+var ReleaseNodesCmd = &cobra.Command{
+	Use:     "nodes",
+	Aliases: aliases.NodesAliases,
+	Short:   constants.ReleaseNodesShortDesc,
+	Long:    constants.ReleaseNodesLongDesc,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if query == "" && len(nodeIDs) == 0 {
+			return fmt.Errorf("either --%s or --%s is required", constants.QueryFlag, constants.NodeIdFlag)
+		}
+		return utils.ValidateRequiredFlags(cmd, []string{constants.OrganizationFlag})
+	},
+	Run: executeReleaseNodes,
+}
+
+func executeReleaseNodes(cmd *cobra.Command, args []string) {
+	filter, err := utils.ParseNodeQuery(query)
+	if err != nil {
+		fmt.Println("Error parsing query:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	selected := previewRelease(filter)
+	if len(selected) == 0 {
+		printReleaseSummary(selected, nil)
+		return
+	}
+	requestBody := model.ReleaseNodesRequest{Org: org}
+	for _, node := range selected {
+		requestBody.NodeIDs = append(requestBody.NodeIDs, node.ID)
+	}
+
+	nodes, err := clients.Client().ReleaseOwnership(context.Background(), requestBody)
+	if err != nil {
+		utils.ExitIfDryRun(err)
+		fmt.Println("Error releasing nodes:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	printReleaseSummary(selected, nodes)
+}
+
+func printReleaseSummary(selected, released []model.Node) {
+	if err := render.Print(utils.SummarizeOwnership("released", org, selected, released)); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+// previewRelease selects the org-owned nodes matching the query or the node
+// IDs, shows them and asks for confirmation unless --yes is set or it is a
+// dry run.
+func previewRelease(filter *utils.NodeFilter) []model.Node {
+	var owned []model.Node
+	var err error
+	if len(filter.Server) == 0 {
+		owned, err = clients.Client().ListOrgOwnedNodes(context.Background(), org)
+	} else {
+		owned, err = clients.Client().QueryOrgOwnedNodes(context.Background(), org, filter.Server)
+	}
+	if err != nil {
+		fmt.Println("Error sending list allocated nodes request:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	selected := filter.Filter(owned)
+	if len(nodeIDs) > 0 {
+		selected, err = ownedNodes(owned, nodeIDs)
+		if err != nil {
+			fmt.Println("Error:", err)
+			os.Exit(utils.ExitCode(err))
+		}
+	}
+	if len(selected) == 0 || yes || clients.Client().DryRun() {
+		return selected
+	}
+
+	if err := render.PreviewNodes("Nodes to release", selected, len(selected)); err != nil {
+		fmt.Println("Error printing preview:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	confirmed, err := utils.Confirm(fmt.Sprintf("Release %s of %s?", render.NodeCount(len(selected)), org))
+	if err != nil {
+		fmt.Printf("Error: %v, use --%s to release without confirmation\n", err, constants.YesFlag)
+		os.Exit(constants.ExitCodeError)
+	}
+	if !confirmed {
+		fmt.Println("Release cancelled, no nodes were released.")
+		os.Exit(constants.ExitCodeError)
+	}
+	return selected
+}
+
+func ownedNodes(owned []model.Node, ids []string) ([]model.Node, error) {
+	byID := make(map[string]model.Node, len(owned))
+	for _, node := range owned {
+		byID[node.ID] = node
+	}
+
+	nodes := make([]model.Node, 0, len(ids))
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("node %s %w among the nodes owned by %s", id, utils.ErrNotFound, org)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func init() {
+	ReleaseNodesCmd.Flags().StringVarP(&org, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	ReleaseNodesCmd.Flags().StringVarP(&query, constants.QueryFlag, constants.QueryFlagShorthandFlag, "", constants.ReleaseQueryDescription)
+	ReleaseNodesCmd.Flags().StringSliceVarP(&nodeIDs, constants.NodeIdFlag, constants.NodeIdShorthandFlag, nil, constants.ReleaseNodeIdsDescription)
+	ReleaseNodesCmd.Flags().BoolVarP(&yes, constants.YesFlag, constants.YesShorthandFlag, false, constants.YesDescription)
+
+	ReleaseNodesCmd.MarkFlagRequired(constants.OrganizationFlag)
+	ReleaseNodesCmd.MarkFlagsMutuallyExclusive(constants.QueryFlag, constants.NodeIdFlag)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	filePath    string
+	overlays    []string
+	valuesFiles []string
+	sets        []string
+)
+
+var RenderConfigCmd = &cobra.Command{
+	Use:     "config",
+	Aliases: aliases.ConfigAliases,
+	Short:   constants.RenderConfigShortDesc,
+	Long:    constants.RenderConfigLongDesc,
+	Run:     executeRenderConfig,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return utils.ValidateRequiredFlags(cmd, []string{constants.FilePathFlag})
+	},
+}
+
+func executeRenderConfig(cmd *cobra.Command, args []string) {
+	document, err := utils.BuildConfigDocument(utils.ConfigSources{Path: filePath, Overlays: overlays, Values: valuesFiles, Sets: sets})
+	if err != nil {
+		fmt.Println("Error rendering config:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	if err := render.Print(document); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	RenderConfigCmd.Flags().StringVarP(&filePath, constants.FilePathFlag, constants.FilePathShorthandFlag, "", constants.FilePathDescription)
+	RenderConfigCmd.Flags().StringArrayVar(&overlays, constants.OverlayFlag, nil, constants.OverlayDescription)
+	RenderConfigCmd.Flags().StringArrayVar(&valuesFiles, constants.ValuesFlag, nil, constants.ValuesDescription)
+	RenderConfigCmd.Flags().StringArrayVar(&sets, constants.SetFlag, nil, constants.SetDescription)
+	RenderConfigCmd.MarkFlagRequired(constants.FilePathFlag)
+}
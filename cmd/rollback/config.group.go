@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	organization string
+	namespace    string
+	name         string
+	toVersion    string
+	asVersion    string
+	place        bool
+)
+
+var RollbackConfigGroupCmd = &cobra.Command{
+	Use:     "group",
+	Aliases: aliases.GroupAliases,
+	Short:   constants.RollbackConfigGroupShortDesc,
+	Long:    constants.RollbackConfigGroupLongDesc,
+	Run:     executeRollbackConfigGroup,
+	PreRunE: validateRollbackFlags,
+}
+
+func executeRollbackConfigGroup(cmd *cobra.Command, args []string) {
+	results, err := clients.RollbackConfigGroup(context.Background(), rollbackReference(), asVersion, place)
+	printRollbackResults(results, err)
+}
+
+func validateRollbackFlags(cmd *cobra.Command, args []string) error {
+	if err := utils.ValidateRequiredFlags(cmd, []string{constants.NamespaceFlag, constants.OrganizationFlag, constants.NameFlag, constants.ToFlag, constants.AsFlag}); err != nil {
+		return err
+	}
+	if toVersion == asVersion {
+		return &utils.ValidationError{Message: "--as must differ from --to"}
+	}
+	return nil
+}
+
+func rollbackReference() model.ConfigReference {
+	return model.ConfigReference{
+		Organization: organization,
+		Namespace:    namespace,
+		Name:         name,
+		Version:      toVersion,
+	}
+}
+
+// printRollbackResults prints the objects created before a failure too, so
+// that a failed placement still shows the version that was put.
+func printRollbackResults(results []model.Result, err error) {
+	if errors.Is(err, client.ErrDryRun) {
+		for i := range results {
+			results[i].Status += model.DryRunSuffix
+		}
+		err = nil
+	}
+	if len(results) > 0 {
+		if printErr := render.Print(results); printErr != nil {
+			fmt.Println("Error printing response:", printErr)
+			os.Exit(utils.ExitCode(printErr))
+		}
+	}
+	if err != nil {
+		fmt.Println("Error rolling back:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+func init() {
+	RollbackConfigGroupCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	RollbackConfigGroupCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
+	RollbackConfigGroupCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
+	RollbackConfigGroupCmd.Flags().StringVar(&toVersion, constants.ToFlag, "", constants.RollbackToDescription)
+	RollbackConfigGroupCmd.Flags().StringVar(&asVersion, constants.AsFlag, "", constants.RollbackAsDescription)
+	RollbackConfigGroupCmd.Flags().BoolVar(&place, constants.PlaceFlag, false, constants.RollbackPlaceDescription)
+
+	RollbackConfigGroupCmd.MarkFlagRequired(constants.OrganizationFlag)
+	RollbackConfigGroupCmd.MarkFlagRequired(constants.NamespaceFlag)
+	RollbackConfigGroupCmd.MarkFlagRequired(constants.NameFlag)
+	RollbackConfigGroupCmd.MarkFlagRequired(constants.ToFlag)
+	RollbackConfigGroupCmd.MarkFlagRequired(constants.AsFlag)
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+
+	"github.com/spf13/cobra"
+)
+
+var RollbackStandaloneConfigCmd = &cobra.Command{
+	Use:     "config",
+	Aliases: aliases.ConfigAliases,
+	Short:   constants.RollbackStandaloneConfigShortDesc,
+	Long:    constants.RollbackStandaloneConfigLongDesc,
+	Run:     executeRollbackStandaloneConfig,
+	PreRunE: validateRollbackFlags,
+}
+
+func executeRollbackStandaloneConfig(cmd *cobra.Command, args []string) {
+	results, err := clients.RollbackStandaloneConfig(context.Background(), rollbackReference(), asVersion, place)
+	printRollbackResults(results, err)
+}
+
+func init() {
+	RollbackStandaloneConfigCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
+	RollbackStandaloneConfigCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
+	RollbackStandaloneConfigCmd.Flags().StringVarP(&name, constants.NameFlag, constants.NameShorthandFlag, "", constants.NameDescription)
+	RollbackStandaloneConfigCmd.Flags().StringVar(&toVersion, constants.ToFlag, "", constants.RollbackToDescription)
+	RollbackStandaloneConfigCmd.Flags().StringVar(&asVersion, constants.AsFlag, "", constants.RollbackAsDescription)
+	RollbackStandaloneConfigCmd.Flags().BoolVar(&place, constants.PlaceFlag, false, constants.RollbackPlaceDescription)
+
+	RollbackStandaloneConfigCmd.MarkFlagRequired(constants.OrganizationFlag)
+	RollbackStandaloneConfigCmd.MarkFlagRequired(constants.NamespaceFlag)
+	RollbackStandaloneConfigCmd.MarkFlagRequired(constants.NameFlag)
+	RollbackStandaloneConfigCmd.MarkFlagRequired(constants.ToFlag)
+	RollbackStandaloneConfigCmd.MarkFlagRequired(constants.AsFlag)
+}
@@ -3,20 +3,34 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/c12s/cockpit/aliases"
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/clients"
+	"github.com/c12s/cockpit/constants"
+	"github.com/c12s/cockpit/render"
+	"github.com/c12s/cockpit/utils"
 
 	"github.com/spf13/cobra"
 
+	apply "github.com/c12s/cockpit/cmd/apply"
 	auth "github.com/c12s/cockpit/cmd/auth"
 	claim "github.com/c12s/cockpit/cmd/claim"
+	configCmd "github.com/c12s/cockpit/cmd/config"
 	create "github.com/c12s/cockpit/cmd/create"
 	deleteCmd "github.com/c12s/cockpit/cmd/delete"
+	describe "github.com/c12s/cockpit/cmd/describe"
 	diff "github.com/c12s/cockpit/cmd/diff"
 	get "github.com/c12s/cockpit/cmd/get"
+	history "github.com/c12s/cockpit/cmd/history"
 	list "github.com/c12s/cockpit/cmd/list"
 	place "github.com/c12s/cockpit/cmd/place"
 	put "github.com/c12s/cockpit/cmd/put"
+	release "github.com/c12s/cockpit/cmd/release"
+	renderCmd "github.com/c12s/cockpit/cmd/render"
+	rollback "github.com/c12s/cockpit/cmd/rollback"
 	validate "github.com/c12s/cockpit/cmd/validate"
 )
 
@@ -28,9 +42,12 @@ func init() {
 	// Authentication Commands
 	RootCmd.AddCommand(auth.LoginCmd)
 	RootCmd.AddCommand(auth.RegisterCmd)
+	RootCmd.AddCommand(auth.LogoutCmd)
+	RootCmd.AddCommand(auth.WhoAmICmd)
 
 	// List Commands
 	ListCmd.AddCommand(list.NodesCmd)
+	ListCmd.AddCommand(list.ListLabelsCmd)
 	ListCmd.AddCommand(ListConfigCmd)
 	ListCmd.AddCommand(ListStandaloneConfigCmd)
 	ListStandaloneConfigCmd.AddCommand(list.ListStandaloneConfigCmd)
@@ -51,34 +68,64 @@ func init() {
 	RootCmd.AddCommand(PutCmd)
 
 	// Delete Commands
-	DeleteCmd.AddCommand(deleteCmd.DeleteNodeLabelsCmd)
-	DeleteCmd.AddCommand(deleteCmd.DeleteSchemaCmd)
-	DeleteCmd.AddCommand(DeleteStandaloneConfigCmd)
-	DeleteCmd.AddCommand(DeleteConfigCmd)
-	DeleteCmd.AddCommand(deleteCmd.DeleteNamespaceCmd)
-	DeleteCmd.AddCommand(deleteCmd.DeleteAppCmd)
+	deleteCmd.DeleteCmd.AddCommand(deleteCmd.DeleteNodeLabelsCmd)
+	deleteCmd.DeleteCmd.AddCommand(deleteCmd.DeleteSchemaCmd)
+	deleteCmd.DeleteCmd.AddCommand(DeleteStandaloneConfigCmd)
+	deleteCmd.DeleteCmd.AddCommand(DeleteConfigCmd)
+	deleteCmd.DeleteCmd.AddCommand(deleteCmd.DeleteNamespaceCmd)
+	deleteCmd.DeleteCmd.AddCommand(deleteCmd.DeleteAppCmd)
 	DeleteStandaloneConfigCmd.AddCommand(deleteCmd.DeleteStandaloneConfigCmd)
 	DeleteConfigCmd.AddCommand(deleteCmd.DeleteConfigGroupCmd)
-	RootCmd.AddCommand(DeleteCmd)
+	RootCmd.AddCommand(deleteCmd.DeleteCmd)
 
 	// Claim Commands
 	ClaimCmd.AddCommand(claim.ClaimNodesCmd)
 	RootCmd.AddCommand(ClaimCmd)
 
+	// Apply Commands
+	apply.ApplyCmd.AddCommand(apply.ApplyLabelsCmd)
+	RootCmd.AddCommand(apply.ApplyCmd)
+
+	// Release Commands
+	ReleaseCmd.AddCommand(release.ReleaseNodesCmd)
+	RootCmd.AddCommand(ReleaseCmd)
+
+	// History Commands
+	HistoryCmd.AddCommand(HistoryConfigCmd)
+	HistoryCmd.AddCommand(HistoryStandaloneConfigCmd)
+	HistoryConfigCmd.AddCommand(history.HistoryConfigGroupCmd)
+	HistoryStandaloneConfigCmd.AddCommand(history.HistoryStandaloneConfigCmd)
+	RootCmd.AddCommand(HistoryCmd)
+
+	// Rollback Commands
+	RollbackCmd.AddCommand(RollbackConfigCmd)
+	RollbackCmd.AddCommand(RollbackStandaloneConfigCmd)
+	RollbackConfigCmd.AddCommand(rollback.RollbackConfigGroupCmd)
+	RollbackStandaloneConfigCmd.AddCommand(rollback.RollbackStandaloneConfigCmd)
+	RootCmd.AddCommand(RollbackCmd)
+
+	// Render Commands
+	RenderCmd.AddCommand(renderCmd.RenderConfigCmd)
+	RootCmd.AddCommand(RenderCmd)
+
 	// Get Commands
 	GetCmd.AddCommand(get.GetSchemaCmd)
 	GetCmd.AddCommand(GetConfigCmd)
 	GetCmd.AddCommand(GetStandaloneConfigCmd)
-	GetCmd.AddCommand(NodesMetricsCmd)
+	GetCmd.AddCommand(get.GetNodeCmd)
 	GetCmd.AddCommand(get.GetNamespaceHierarchyCmd)
 	GetCmd.AddCommand(get.GetNamespaceCmd)
-	NodesMetricsCmd.AddCommand(get.LatestMetricsCmd)
+	get.GetNodeCmd.AddCommand(get.LatestMetricsCmd)
 	GetStandaloneConfigCmd.AddCommand(get.GetStandaloneConfigCmd)
 	GetConfigCmd.AddCommand(get.GetSingleConfigGroupCmd)
 	get.GetSchemaCmd.AddCommand(get.GetSchemaVersionCmd)
 	RootCmd.AddCommand(GetCmd)
 	RootCmd.AddCommand(GetNodesMetricsCmd)
 
+	// Describe Commands
+	DescribeCmd.AddCommand(describe.DescribeNodeCmd)
+	RootCmd.AddCommand(DescribeCmd)
+
 	// Validate Commands
 	ValidateCmd.AddCommand(validate.ValidateSchemaVersionCmd)
 	RootCmd.AddCommand(ValidateCmd)
@@ -105,12 +152,40 @@ func init() {
 	PlaceConfigGroupCmd.AddCommand(place.PlaceConfigGroupPlacementsCmd)
 	RootCmd.AddCommand(PlaceCmd)
 
+	// Config Commands
+	ConfigCmd.AddCommand(configCmd.UseContextCmd)
+	ConfigCmd.AddCommand(configCmd.GetContextsCmd)
+	ConfigCmd.AddCommand(configCmd.SetContextCmd)
+	ConfigCmd.AddCommand(configCmd.DeleteContextCmd)
+	RootCmd.AddCommand(ConfigCmd)
+
 	RootCmd.PersistentFlags().String(apiVersionFlag, "1.0.0", "specify c12s API version")
+	RootCmd.PersistentFlags().StringVarP(&outputFormat, constants.OutputFlag, constants.OutputShorthandFlag, "", constants.OutputDescription)
+	RootCmd.PersistentFlags().StringVar(&contextName, constants.ContextFlag, "", constants.ContextDescription)
+	RootCmd.PersistentFlags().IntVar(&retries, constants.RetriesFlag, 3, constants.RetriesDescription)
+	RootCmd.PersistentFlags().DurationVar(&retryMaxWait, constants.RetryMaxWaitFlag, 10*time.Second, constants.RetryMaxWaitDescription)
+	RootCmd.PersistentFlags().DurationVar(&timeout, constants.TimeoutFlag, 0, constants.TimeoutDescription)
+	RootCmd.PersistentFlags().BoolVar(&insecure, constants.InsecureFlag, false, constants.InsecureDescription)
+	RootCmd.PersistentFlags().StringVar(&caFile, constants.CAFileFlag, "", constants.CAFileDescription)
+	RootCmd.PersistentFlags().StringVar(&clientCert, constants.ClientCertFlag, "", constants.ClientCertDescription)
+	RootCmd.PersistentFlags().StringVar(&clientKey, constants.ClientKeyFlag, "", constants.ClientKeyDescription)
+	RootCmd.PersistentFlags().StringVar(&proxy, constants.ProxyFlag, "", constants.ProxyDescription)
+	RootCmd.PersistentFlags().StringArrayVar(&headers, constants.HeaderFlag, nil, constants.HeaderDescription)
+	RootCmd.PersistentFlags().IntVar(&verbosity, constants.VerboseFlag, 0, constants.VerboseDescription)
+	RootCmd.PersistentFlags().Lookup(constants.VerboseFlag).NoOptDefVal = "1"
+	RootCmd.PersistentFlags().BoolVar(&debug, constants.DebugFlag, false, constants.DebugDescription)
+	RootCmd.PersistentFlags().StringVar(&dryRun, constants.DryRunFlag, "", constants.DryRunDescription)
+	RootCmd.PersistentFlags().Lookup(constants.DryRunFlag).NoOptDefVal = "true"
+
+	// Read commands can also save their response to a file.
+	for _, cmd := range []*cobra.Command{GetCmd, GetNodesMetricsCmd, ListCmd, DiffCmd, DescribeCmd, HistoryCmd, RenderCmd, auth.WhoAmICmd, configCmd.GetContextsCmd} {
+		cmd.PersistentFlags().StringVar(&saveTo, constants.SaveToFlag, "", constants.SaveToDescription)
+		cmd.PersistentFlags().BoolVar(&force, constants.ForceFlag, false, constants.ForceDescription)
+	}
 }
 
 var (
 	ClaimCmd                      = &cobra.Command{Use: "claim", Short: "Claim resources", Aliases: aliases.ClaimAliases}
-	DeleteCmd                     = &cobra.Command{Use: "delete", Short: "Delete resources", Aliases: aliases.DeleteAliases}
 	DeleteStandaloneConfigCmd     = &cobra.Command{Use: "standalone", Short: "Delete resources", Aliases: aliases.StandaloneAliases}
 	PutCmd                        = &cobra.Command{Use: "put", Short: "Put resources"}
 	PutStandaloneConfigCmd        = &cobra.Command{Use: "standalone", Short: "Put resources", Aliases: aliases.StandaloneAliases}
@@ -131,17 +206,190 @@ var (
 	ListStandaloneConfigCmd       = &cobra.Command{Use: "standalone", Short: "Manipulate with config", Aliases: aliases.StandaloneAliases}
 	ValidateCmd                   = &cobra.Command{Use: "validate", Short: "Validate resources", Aliases: aliases.ValidateAliases}
 	GetNodesMetricsCmd            = &cobra.Command{Use: "get", Short: "Get resources", Aliases: aliases.FetchAliases}
-	NodesMetricsCmd               = &cobra.Command{Use: "nodes", Short: "Nodes resources", Aliases: aliases.NodesAliases}
+	ReleaseCmd                    = &cobra.Command{Use: "release", Short: "Release resources"}
+	HistoryCmd                    = &cobra.Command{Use: "history", Short: "Show the version history of resources", Aliases: aliases.HistoryAliases}
+	HistoryConfigCmd              = &cobra.Command{Use: "config", Short: "Show the version history of resources", Aliases: aliases.ConfigAliases}
+	HistoryStandaloneConfigCmd    = &cobra.Command{Use: "standalone", Short: "Show the version history of resources", Aliases: aliases.StandaloneAliases}
+	RollbackCmd                   = &cobra.Command{Use: "rollback", Short: "Roll back resources to an earlier version"}
+	RollbackConfigCmd             = &cobra.Command{Use: "config", Short: "Roll back resources to an earlier version", Aliases: aliases.ConfigAliases}
+	RollbackStandaloneConfigCmd   = &cobra.Command{Use: "standalone", Short: "Roll back resources to an earlier version", Aliases: aliases.StandaloneAliases}
+	DescribeCmd                   = &cobra.Command{Use: "describe", Short: "Describe resources", Aliases: aliases.DescribeAliases}
+
+	// Context commands manage the client config themselves, so they skip context resolution.
+	ConfigCmd = &cobra.Command{
+		Use:   "config",
+		Short: constants.ConfigShortDesc,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			initOutput()
+			return nil
+		},
+	}
+
+	// Render commands build resources from local files only.
+	RenderCmd = &cobra.Command{
+		Use:   "render",
+		Short: "Render resources from local files",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			initOutput()
+			return nil
+		},
+	}
 
 	RootCmd = &cobra.Command{
-		Use:   "cockpit",
-		Short: "Cockpit is a CLI tool for interacting with the c12s system",
+		Use:               "cockpit",
+		Short:             "Cockpit is a CLI tool for interacting with the c12s system",
+		PersistentPreRunE: initContext,
 	}
+
+	contextName  string
+	retries      int
+	retryMaxWait time.Duration
+	timeout      time.Duration
+	insecure     bool
+	caFile       string
+	clientCert   string
+	clientKey    string
+	proxy        string
+	headers      []string
+	verbosity    int
+	debug        bool
+	dryRun       string
+	outputFormat string
+	saveTo       string
+	force        bool
 )
 
+func initContext(cmd *cobra.Command, args []string) error {
+	if cmd.Name() == "help" || (cmd.HasParent() && cmd.Parent().Name() == "completion") {
+		return nil
+	}
+
+	initOutput()
+
+	if err := clients.Init(contextName); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	if err := connect(cmd); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+
+	clients.Configure(client.WithRetries(retries, retryMaxWait))
+	applyContextDefaults(cmd)
+	return nil
+}
+
+func initOutput() {
+	if err := render.SetOutput(outputFormat); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+	if err := render.SetSaveTo(saveTo, force); err != nil {
+		fmt.Println("Error:", err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+// connect sets up the gateway transport from the active context, letting
+// the global flags override its settings.
+func connect(cmd *cobra.Command) error {
+	conn, err := clients.ContextConnection()
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed(constants.TimeoutFlag) {
+		conn.Timeout = timeout
+	}
+	if flags.Changed(constants.InsecureFlag) {
+		conn.Transport.InsecureSkipVerify = insecure
+	}
+	if flags.Changed(constants.CAFileFlag) {
+		conn.Transport.CAFile = caFile
+	}
+	if flags.Changed(constants.ClientCertFlag) {
+		conn.Transport.CertFile = clientCert
+	}
+	if flags.Changed(constants.ClientKeyFlag) {
+		conn.Transport.KeyFile = clientKey
+	}
+	if flags.Changed(constants.ProxyFlag) {
+		conn.Transport.Proxy = proxy
+	}
+
+	conn.Headers, err = utils.ParseHeaders(headers)
+	if err != nil {
+		return err
+	}
+
+	conn.TraceLevel = verbosity
+	if debug {
+		conn.TraceLevel = client.TraceBodies
+	}
+
+	conn.DryRun, err = dryRunHandler(dryRun)
+	if err != nil {
+		return err
+	}
+
+	return clients.Connect(conn)
+}
+
+// dryRunHandler prints every request that would change the system as the
+// command makes it. The client returns client.ErrDryRun instead of sending
+// it, which commands treat as success.
+func dryRunHandler(mode string) (client.DryRunHandler, error) {
+	var renderRequest func(client.DryRunRequest)
+	switch mode {
+	case "", "false":
+		return nil, nil
+	case "true":
+		renderRequest = render.RenderDryRunRequest
+	case "curl":
+		renderRequest = render.RenderDryRunCurl
+	default:
+		return nil, fmt.Errorf("invalid --%s value %q, expected true or curl", constants.DryRunFlag, mode)
+	}
+
+	// Requests made in parallel, as by apply labels, are printed one at a time.
+	var mu sync.Mutex
+	printed := 0
+	return func(request client.DryRunRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if printed > 0 && mode == "true" {
+			fmt.Println()
+		}
+		renderRequest(request)
+		printed++
+		return nil
+	}, nil
+}
+
+// applyContextDefaults fills --org and --namespace from the active context
+// when the command has those flags and the user did not set them.
+func applyContextDefaults(cmd *cobra.Command) {
+	ctx := clients.CurrentContext()
+	defaults := map[string]string{
+		constants.OrganizationFlag: ctx.Organization,
+		constants.NamespaceFlag:    ctx.Namespace,
+	}
+
+	for flagName, value := range defaults {
+		flag := cmd.Flags().Lookup(flagName)
+		if flag == nil || flag.Changed || value == "" {
+			continue
+		}
+		cmd.Flags().Set(flagName, value)
+	}
+}
+
 func Execute() {
 	if err := RootCmd.Execute(); err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 }
@@ -1,15 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
-	"time"
 
 	"github.com/c12s/cockpit/aliases"
 	"github.com/c12s/cockpit/clients"
 	"github.com/c12s/cockpit/constants"
 	"github.com/c12s/cockpit/model"
+	"github.com/c12s/cockpit/render"
 	"github.com/c12s/cockpit/utils"
 
 	"github.com/spf13/cobra"
@@ -38,21 +39,24 @@ func executeValidateSchemaVersion(cmd *cobra.Command, args []string) {
 	requestBody, err := prepareValidateSchemaRequestConfig()
 	if err != nil {
 		fmt.Println("Error preparing request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	if err := sendValidateSchemaRequest(requestBody); err != nil {
+	if err := clients.Client().ValidateConfiguration(context.Background(), requestBody); err != nil {
 		fmt.Println("Error sending validate schema request:", err)
-		os.Exit(1)
+		os.Exit(utils.ExitCode(err))
 	}
 
-	fmt.Println("Schema validated successfully!")
+	if err := render.Print(model.Result{Kind: "schema", Name: utils.SchemaName(requestBody.SchemaDetails), Status: "validated"}); err != nil {
+		fmt.Println("Error printing response:", err)
+		os.Exit(utils.ExitCode(err))
+	}
 }
 
-func prepareValidateSchemaRequestConfig() (interface{}, error) {
+func prepareValidateSchemaRequestConfig() (model.ValidateConfigurationRequest, error) {
 	configData, err := ioutil.ReadFile(configPath)
 	if err != nil {
-		return nil, fmt.Errorf("error reading config file: %v", err)
+		return model.ValidateConfigurationRequest{}, fmt.Errorf("error reading config file: %v", err)
 	}
 
 	schemaDetails := model.SchemaDetails{
@@ -62,10 +66,7 @@ func prepareValidateSchemaRequestConfig() (interface{}, error) {
 		Version:      version,
 	}
 
-	requestBody := struct {
-		SchemaDetails model.SchemaDetails `json:"schema_details"`
-		Configuration string              `json:"configuration"`
-	}{
+	requestBody := model.ValidateConfigurationRequest{
 		SchemaDetails: schemaDetails,
 		Configuration: string(configData),
 	}
@@ -73,23 +74,6 @@ func prepareValidateSchemaRequestConfig() (interface{}, error) {
 	return requestBody, nil
 }
 
-func sendValidateSchemaRequest(requestBody interface{}) error {
-	token, err := utils.ReadTokenFromFile()
-	if err != nil {
-		return fmt.Errorf("error reading token: %v", err)
-	}
-
-	url := clients.BuildURL("core", "v1", "ValidateConfiguration")
-
-	return utils.SendHTTPRequest(model.HTTPRequestConfig{
-		Method:      "GET",
-		URL:         url,
-		Token:       token,
-		Timeout:     10 * time.Second,
-		RequestBody: requestBody,
-	})
-}
-
 func init() {
 	ValidateSchemaVersionCmd.Flags().StringVarP(&organization, constants.OrganizationFlag, constants.OrganizationShorthandFlag, "", constants.OrganizationDescription)
 	ValidateSchemaVersionCmd.Flags().StringVarP(&namespace, constants.NamespaceFlag, constants.NamespaceShorthandFlag, "", constants.NamespaceDescription)
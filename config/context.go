@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	configDirName  = ".cockpit"
+	configFileName = "config"
+	configPathEnv  = "COCKPIT_CONFIG"
+)
+
+type ClientConfig struct {
+	CurrentContext string    `json:"current-context" yaml:"current-context"`
+	Contexts       []Context `json:"contexts" yaml:"contexts"`
+}
+
+type Context struct {
+	Name            string   `json:"name" yaml:"name"`
+	Gateway         Endpoint `json:"gateway" yaml:"gateway"`
+	Routes          string   `json:"routes" yaml:"routes"`
+	Organization    string   `json:"organization,omitempty" yaml:"organization,omitempty"`
+	Namespace       string   `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	User            string   `json:"user,omitempty" yaml:"user,omitempty"`
+	CredentialStore string   `json:"credential-store,omitempty" yaml:"credential-store,omitempty"`
+	AutoRelogin     bool     `json:"auto-relogin,omitempty" yaml:"auto-relogin,omitempty"`
+	Timeout         string   `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	TLS             TLS      `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Proxy           string   `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+	// Metrics is the URL of the metrics API. It defaults to port 8086 of the
+	// gateway host.
+	Metrics string `json:"metrics,omitempty" yaml:"metrics,omitempty"`
+}
+
+type TLS struct {
+	CAFile             string `json:"certificate-authority,omitempty" yaml:"certificate-authority,omitempty"`
+	CertFile           string `json:"client-certificate,omitempty" yaml:"client-certificate,omitempty"`
+	KeyFile            string `json:"client-key,omitempty" yaml:"client-key,omitempty"`
+	InsecureSkipVerify bool   `json:"insecure-skip-tls-verify,omitempty" yaml:"insecure-skip-tls-verify,omitempty"`
+}
+
+// Enabled reports whether the context configures any TLS setting, which
+// implies an https gateway when no scheme is given.
+func (t TLS) Enabled() bool {
+	return t.CAFile != "" || t.CertFile != "" || t.KeyFile != "" || t.InsecureSkipVerify
+}
+
+type Endpoint struct {
+	Scheme string `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Host   string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port   string `json:"port,omitempty" yaml:"port,omitempty"`
+	Route  string `json:"route,omitempty" yaml:"route,omitempty"`
+}
+
+// ClientConfigPath returns the location of the cockpit client config,
+// honoring COCKPIT_CONFIG before falling back to ~/.cockpit/config.
+func ClientConfigPath() (string, error) {
+	if path := os.Getenv(configPathEnv); path != "" {
+		return path, nil
+	}
+
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFileName), nil
+}
+
+// ConfigDir returns the directory holding cockpit's per-user state.
+func ConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %v", err)
+	}
+	return filepath.Join(home, configDirName), nil
+}
+
+// LoadClientConfig reads the client config at path. A missing file yields an
+// empty config so that the first set-context can create it.
+func LoadClientConfig(path string) (*ClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &ClientConfig{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var conf ClientConfig
+	if err := yaml.Unmarshal(data, &conf); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return &conf, nil
+}
+
+func (c *ClientConfig) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+func (c *ClientConfig) GetContext(name string) (*Context, bool) {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i], true
+		}
+	}
+	return nil, false
+}
+
+// SetContext inserts ctx or replaces the context with the same name.
+func (c *ClientConfig) SetContext(ctx Context) {
+	if existing, ok := c.GetContext(ctx.Name); ok {
+		*existing = ctx
+		return
+	}
+	c.Contexts = append(c.Contexts, ctx)
+}
+
+func (c *ClientConfig) DeleteContext(name string) bool {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			c.Contexts = append(c.Contexts[:i], c.Contexts[i+1:]...)
+			if c.CurrentContext == name {
+				c.CurrentContext = ""
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveContext picks the named context, or the current one when name is empty.
+func (c *ClientConfig) ResolveContext(name string) (*Context, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return nil, fmt.Errorf("no context selected, run 'cockpit config use-context <name>' or pass --context")
+	}
+
+	ctx, ok := c.GetContext(name)
+	if !ok {
+		return nil, fmt.Errorf("context %q not found", name)
+	}
+	return ctx, nil
+}
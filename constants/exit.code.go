@@ -0,0 +1,16 @@
+package constants
+
+const (
+	ExitCodeOK         = 0
+	ExitCodeError      = 1
+	ExitCodeAuth       = 2
+	ExitCodeNotFound   = 3
+	ExitCodeConflict   = 4
+	ExitCodeValidation = 5
+	ExitCodeNetwork    = 6
+	ExitCodeTimeout    = 7
+
+	// ExitCodeDifferences is returned by local diffs that found differences,
+	// distinct from ExitCodeError so that scripts can tell them from failures.
+	ExitCodeDifferences = 8
+)
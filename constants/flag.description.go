@@ -1,26 +1,79 @@
 package constants
 
 const (
-	EmailDescription              = "Email for registration"
-	NameDescription               = "Name for registration"
-	SurnameDescription            = "Surname for registration"
-	UsernameDescription           = "Username for registration"
-	NodeQueryDescription          = "Query label for finding specific nodes"
-	NodeQueryRequiredDescription  = "Query label for finding specific nodes (required)"
-	IdsDescription                = "IDs of the entities separated by '|' (required)"
-	KindsDescription              = "Kinds of the entities separated by '|' (required)"
-	OrganizationDescription       = "Organization name (required)"
-	NamespaceDescription          = "Namespace name (required)"
-	SchemaNameDescription         = "Schema name (required)"
-	VersionDescription            = "Version of entity (required)"
-	FilePathDescription           = "Path to the YAML or JSON file (required)"
-	OutputDescription             = "Output format (json or yaml)"
-	NodeIdDescription             = "Node ID (required)"
-	ClusterIdDescription          = "Cluster ID"
-	LabelKeyDescription           = "Label key (required)"
-	ConfigDiffNamesDescription    = "Configuration names separated by '|' (required)"
-	ConfigDiffVersionsDescription = "Configuration versions separated by '|' (required)"
-	AllServicesDescription        = "Display metrics for all app services (optional)"
-	SortMetricsDescription        = "Sort metrics by 'cpu' (default), 'memory', 'disk', 'network receive', 'network transmit' or 'bandwidth'"
-	LabelValueDescription         = "Label value (required)"
+	EmailDescription               = "Email for registration"
+	NameDescription                = "Name for registration"
+	SurnameDescription             = "Surname for registration"
+	UsernameDescription            = "Username for registration"
+	NodeQueryDescription           = "Query label for finding specific nodes"
+	NodeQueryRequiredDescription   = "Query label for finding specific nodes (required)"
+	IdsDescription                 = "IDs of the entities separated by '|' (required)"
+	KindsDescription               = "Kinds of the entities separated by '|' (required)"
+	OrganizationDescription        = "Organization name (required)"
+	NamespaceDescription           = "Namespace name (required)"
+	SchemaNameDescription          = "Schema name (required)"
+	VersionDescription             = "Version of entity (required)"
+	FilePathDescription            = "Path to the YAML or JSON file (required)"
+	OutputDescription              = "Output format: table, wide, json, yaml, csv, name, jsonpath=<expression> or go-template=<template>"
+	NodeIdDescription              = "Node ID (required)"
+	ClusterIdDescription           = "Cluster ID"
+	LabelKeyDescription            = "Label key (required)"
+	ConfigDiffNamesDescription     = "Configuration names separated by '|' (required without --local)"
+	ConfigDiffVersionsDescription  = "Configuration versions separated by '|' (required without --local)"
+	AllServicesDescription         = "Display metrics for all app services (optional)"
+	SortMetricsDescription         = "Sort metrics by 'cpu' (default), 'memory', 'disk', 'network receive', 'network transmit' or 'bandwidth'"
+	LabelValueDescription          = "Label value (required)"
+	ContextDescription             = "Name of the client config context to use (defaults to the current context)"
+	SchemeDescription              = "Gateway scheme (http or https)"
+	HostDescription                = "Gateway host"
+	PortDescription                = "Gateway port (defaults to the port from the routes file)"
+	RouteDescription               = "Gateway route prefix (defaults to the route from the routes file)"
+	RoutesDescription              = "Path to the gateway route configuration file"
+	DefaultOrganizationDescription = "Default organization used when --org is not given"
+	DefaultNamespaceDescription    = "Default namespace used when --namespace is not given"
+	UserDescription                = "User whose stored token is sent with requests (set by login)"
+	CredentialStoreDescription     = "Credential store backend: file (default) or encrypted"
+	AutoReloginDescription         = "Prompt for the password and retry when the token is expired or rejected"
+	RetriesDescription             = "Number of retries for idempotent requests that fail with connection errors or 408/429/502/503/504"
+	RetryMaxWaitDescription        = "Maximum wait between two retries (e.g. 500ms, 10s)"
+	TimeoutDescription             = "Timeout of a single gateway request (e.g. 30s, defaults to 10s)"
+	InsecureDescription            = "Skip verification of the gateway's TLS certificate"
+	CAFileDescription              = "PEM file with CA certificates trusted for the gateway, in addition to the system roots"
+	ClientCertDescription          = "PEM client certificate presented to the gateway (mTLS)"
+	ClientKeyDescription           = "PEM private key of the client certificate"
+	ProxyDescription               = "Proxy URL for gateway requests, overriding HTTP_PROXY, HTTPS_PROXY and NO_PROXY"
+	HeaderDescription              = "Extra request header as key=value (repeatable)"
+	VerboseDescription             = "Trace requests to stderr: 1 method, URL, status and timing, 2 adds headers, 3 adds bodies"
+	DebugDescription               = "Trace requests to stderr with headers and bodies (same as --verbose=3)"
+	DryRunDescription              = "Print the gateway requests that would change the system instead of sending them: --dry-run for a summary, --dry-run=curl for curl commands"
+	SaveToDescription              = "Also write the response to this file, as JSON or YAML depending on its extension or --output"
+	ForceDescription               = "Overwrite the --save-to file if it already exists"
+	NodeColumnsDescription         = "Label keys to show as table columns, separated by commas"
+	NodeSortByDescription          = "Sort nodes by a label value, numerically for numbers; prefix with '-' for descending order"
+	LabelSelectorDescription       = "Keep only nodes matching the selector, e.g. 'env=prod,zone in (eu, us),!gpu'"
+	LimitDescription               = "Show at most this many nodes (0 for all)"
+	ClaimCountDescription          = "Claim exactly this many of the matching nodes, failing if fewer match"
+	ClaimMaxDescription            = "Claim at most this many of the matching nodes"
+	ClaimSortByDescription         = "Label deciding which matching nodes are claimed first with --count or --max; prefix with '-' for descending order"
+	YesDescription                 = "Skip the confirmation prompt"
+	ReleaseNodeIdsDescription      = "IDs of the nodes to release, separated by commas (instead of --query)"
+	ReleaseQueryDescription        = "Query selecting the nodes to release (instead of --node-id)"
+	LabelsFileDescription          = "Path to the YAML or JSON file listing the labels to apply (required)"
+	LabelsOrgDescription           = "Organization owning the nodes, overriding org from the file"
+	ParallelDescription            = "Number of nodes changed at the same time"
+	ListLabelsOrgDescription       = "Organization owning the node, to look it up among its nodes before the node pool"
+	FilenameDescription            = "Manifest file, directory of manifest files, or - for stdin (repeatable)"
+	IgnoreNotFoundDescription      = "Report objects that do not exist as not found instead of failing"
+	LabelTypeDescription           = "Label value type: string, float or bool (inferred from the value when not set)"
+	LocalConfigDescription         = "Path to a local YAML or JSON config file to compare with the --against version"
+	AgainstDescription             = "Stored version the --local file is compared with, as [[org/]namespace/]name@version"
+	RollbackToDescription          = "Earlier version to roll back to (required)"
+	RollbackAsDescription          = "New version to put the earlier version's params under (required)"
+	RollbackPlaceDescription       = "Place the new version with the strategy the config was last placed with"
+	BumpDescription                = "Put the file as the next major, minor or patch version after the existing ones"
+	AllowExistingDescription       = "With --bump, put the config even if the new version already exists"
+	OverlayDescription             = "YAML or JSON file merged over the config file by param set name and key (repeatable)"
+	ValuesDescription              = "YAML file of values the config and overlay files are rendered with as Go templates (repeatable)"
+	MetricsURLDescription          = "URL of the context's metrics API (defaults to port 8086 of the gateway host)"
+	SetDescription                 = "Param to set after the overlays, as paramSet.key=value, or key=value for standalone configs (repeatable)"
 )
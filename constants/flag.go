@@ -1,25 +1,69 @@
 package constants
 
 const (
-	EmailFlag        = "email"
-	NameFlag         = "name"
-	SurnameFlag      = "surname"
-	UsernameFlag     = "username"
-	OrganizationFlag = "org"
-	NamespaceFlag    = "namespace"
-	QueryFlag        = "query"
-	IdsFlag          = "ids"
-	KindsFlag        = "kinds"
-	SchemaNameFlag   = "schema-name"
-	VersionFlag      = "version"
-	FilePathFlag     = "path"
-	OutputFlag       = "output"
-	NodeIdFlag       = "node-id"
-	ClusterIdFlag    = "cluster-id"
-	KeyFlag          = "key"
-	NamesFlag        = "names"
-	VersionsFlag     = "versions"
-	AllServicesFlag  = "all-services"
-	SortByFlag       = "sort-by"
-	ValueFlag        = "value"
+	EmailFlag           = "email"
+	NameFlag            = "name"
+	SurnameFlag         = "surname"
+	UsernameFlag        = "username"
+	OrganizationFlag    = "org"
+	NamespaceFlag       = "namespace"
+	QueryFlag           = "query"
+	IdsFlag             = "ids"
+	KindsFlag           = "kinds"
+	SchemaNameFlag      = "schema-name"
+	VersionFlag         = "version"
+	FilePathFlag        = "path"
+	OutputFlag          = "output"
+	NodeIdFlag          = "node-id"
+	ClusterIdFlag       = "cluster-id"
+	KeyFlag             = "key"
+	NamesFlag           = "names"
+	VersionsFlag        = "versions"
+	AllServicesFlag     = "all-services"
+	SortByFlag          = "sort-by"
+	ValueFlag           = "value"
+	ContextFlag         = "context"
+	SchemeFlag          = "scheme"
+	HostFlag            = "host"
+	PortFlag            = "port"
+	RouteFlag           = "route"
+	RoutesFlag          = "routes"
+	UserFlag            = "user"
+	CredentialStoreFlag = "credential-store"
+	AutoReloginFlag     = "auto-relogin"
+	RetriesFlag         = "retries"
+	RetryMaxWaitFlag    = "retry-max-wait"
+	TimeoutFlag         = "timeout"
+	InsecureFlag        = "insecure"
+	CAFileFlag          = "ca-file"
+	ClientCertFlag      = "client-cert"
+	ClientKeyFlag       = "client-key"
+	ProxyFlag           = "proxy"
+	HeaderFlag          = "header"
+	VerboseFlag         = "verbose"
+	DebugFlag           = "debug"
+	DryRunFlag          = "dry-run"
+	SaveToFlag          = "save-to"
+	ForceFlag           = "force"
+	ColumnsFlag         = "columns"
+	LabelSelectorFlag   = "label-selector"
+	ParallelFlag        = "parallel"
+	TypeFlag            = "type"
+	FilenameFlag        = "filename"
+	IgnoreNotFoundFlag  = "ignore-not-found"
+	LimitFlag           = "limit"
+	CountFlag           = "count"
+	MaxFlag             = "max"
+	YesFlag             = "yes"
+	LocalFlag           = "local"
+	AgainstFlag         = "against"
+	ToFlag              = "to"
+	AsFlag              = "as"
+	PlaceFlag           = "place"
+	BumpFlag            = "bump"
+	AllowExistingFlag   = "allow-existing"
+	OverlayFlag         = "overlay"
+	ValuesFlag          = "values"
+	SetFlag             = "set"
+	MetricsURLFlag      = "metrics-url"
 )
@@ -22,4 +22,8 @@ const (
 	NodeIdShorthandFlag       = "n"
 	ClusterIdShorthandFlag    = "c"
 	ValueShorthandFlag        = "v"
+	ColumnsShorthandFlag      = "c"
+	LabelSelectorShorthand    = "l"
+	YesShorthandFlag          = "y"
+	FilenameShorthandFlag     = "f"
 )
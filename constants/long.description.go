@@ -2,7 +2,8 @@ package constants
 
 const (
 	LongLoginDesc = `Input your username after that you will be prompted to input your password.
-Your token will be saved in the token.txt file, which will be sent with all of your request headers.
+Your token will be saved in the credential store of the current context (under ~/.cockpit), keyed by gateway and username,
+and will be sent with all of your request headers. The context remembers the logged in user.
 
 Example:
 - cockpit login --username "username"`
@@ -15,11 +16,16 @@ Example:
 
 	ClaimNodesLongDesc = `Claims nodes for an organization based on a defined query that specifies criteria like labels.
 The command allows the organization to take ownership of nodes that match the provided query criteria.
-The query can include conditions based on node labels such as memory, CPU, and other attributes. 
+The query can include conditions based on node labels such as memory, CPU, and other attributes.
+Since the gateway claims the nodes itself, the query may only join =, !=, > and < comparisons with and.
+The matching nodes are shown before a confirmation prompt, which --yes skips. --count and --max claim only some of them,
+picked by the --sort-by label (largest memory first by default), and claim them one request per node, adding id = <node> to the query.
+The command ends with a summary of claimed and skipped nodes.
 
 Example:
 - cockpit claim nodes --org 'org' --query 'labelKey >, =, !=, or < value'
-- cockpit claim nodes --org 'org' --query 'memory-totalGB > 2'`
+- cockpit claim nodes --org 'org' --query 'memory-totalGB > 2 and cpu-cores > 2'
+- cockpit claim nodes --org 'org' --query 'cpu-cores > 2' --max 3 --yes`
 
 	CreatePoliciesLongDesc = `This command is for creating security policies based on the input file.
 Policies are used to define and enforce security rules within the organization. The input file can be in YAML or JSON format, specifying the policy details.
@@ -86,21 +92,38 @@ The user can specify the organization, standalone configuration name, and versio
 Example:
 - cockpit delete standalone config --org 'c12s' --name 'db_config' --version 'v1.0.1'`
 
-	DiffConfigGroupLongDesc = `This command compares two configuration groups specified by their names and versions, displays the differences, and saves them to both YAML and JSON files (optional).
+	DiffConfigGroupLongDesc = `This command compares two configuration groups specified by their names and versions and displays the differences, optionally saving them with --save-to.
 The user can specify the organization, names, and versions of the two configuration groups to be compared.
 
 Example:
 - cockpit diff config group --org 'org' --names 'name1|name2' --versions 'version1|version2'
+- cockpit diff config group --org 'org' --names 'name1|name2' --versions 'version' --save-to './diff.yaml'
 - cockpit diff config group --org 'org' --names 'name' --versions 'version1|version2'
-- cockpit diff config group --org 'org' --names 'name1|name2' --versions 'version'`
+- cockpit diff config group --org 'org' --names 'name1|name2' --versions 'version'
 
-	DiffStandaloneConfigLongDesc = `This command compares two standalone configurations specified by their names and versions, displays the differences, and saves them to both YAML and JSON files (optional).
+The two versions can also be given as FROM and TO references of the form [[org/]namespace/]name@version, which may be in different organizations and namespaces. Parts left out are taken from --org and --namespace.
+- cockpit diff config group 'org/dev/name@version' 'org/prod/name@version'
+
+With --local, a config group file is compared with a stored version before it is pushed. The organization and namespace come from the file, or from --org and --namespace when the file leaves them out.
+The differences are shown as a unified diff, and the command exits with code 8 when there are any, so it can gate CI pipelines.
+- cockpit diff config group --local './group.yaml' --against 'name@version'
+- cockpit diff config group --local './group.yaml' --against 'org/dev/name@version'`
+
+	DiffStandaloneConfigLongDesc = `This command compares two standalone configurations specified by their names and versions and displays the differences, optionally saving them with --save-to.
 The user can specify the organization, names, and versions of the two configuration groups to be compared.
 
 Example:
 - cockpit diff standalone config --org 'org' --names 'name1|name2' --versions 'version1|version2'
 - cockpit diff standalone config--org 'org' --names 'name' --versions 'version1|version2'
-- cockpit diff standalone config --org 'org' --names 'name1|name2' --versions 'version'`
+- cockpit diff standalone config --org 'org' --names 'name1|name2' --versions 'version'
+
+The two versions can also be given as FROM and TO references of the form [[org/]namespace/]name@version, which may be in different organizations and namespaces. Parts left out are taken from --org and --namespace.
+- cockpit diff standalone config 'org/dev/name@version' 'org/prod/name@version'
+
+With --local, a standalone config file is compared with a stored version before it is pushed. The organization and namespace come from the file, or from --org and --namespace when the file leaves them out.
+The differences are shown as a unified diff, and the command exits with code 8 when there are any, so it can gate CI pipelines.
+- cockpit diff standalone config --local './config.yaml' --against 'name@version'
+- cockpit diff standalone config --local './config.yaml' --against 'org/dev/name@version'`
 
 	GetAppConfigLongDesc = `This command retrieves a specific configuration by its organization, name, and version.
 The user can specify the organization, configuration name, and version to retrieve the configuration details. The response can be formatted as either YAML or JSON based on user preference.
@@ -120,7 +143,7 @@ The user can specify the node ID to retrieve the metrics.
 Example:
 - cockpit get nodes metrics --node-id 'nodeID'`
 
-	GetSchemaLongDesc = `This command retrieves the schema from a specified organization and specific version and saves it to a YAML or JSON file (optional).
+	GetSchemaLongDesc = `This command retrieves the schema from a specified organization and specific version, optionally saving it to a YAML or JSON file with --save-to.
 The user can specify the organization, schema name, and version to retrieve the schema details.
 
 Example:
@@ -134,11 +157,12 @@ Example:
 
 	AllocatedNodesLongDesc = `This command allows you to list all nodes allocated to a specified organization.
 You can also use a query to search for nodes based on their labels.
-The query format allows you to filter nodes using operators like >, =, !=, and < with the label values.
+The query compares label values with =, !=, >, >=, < and <=, matches lists with in [...], and combines conditions with and, or, not and parentheses.
 
 Examples:
 - cockpit list nodes allocated --org 'org' --query 'labelKey >, =, !=, or < value'
-- cockpit list nodes allocated --org 'org' --query 'memory-totalGB > 2'`
+- cockpit list nodes allocated --org 'org' --query 'memory-totalGB > 2'
+- cockpit list nodes allocated --org 'org' --query 'cpu-cores >= 4 and (memory-totalGB > 8 or disk-freeGB > 100)'`
 
 	ListConfigGroupPlacementsLongDesc = `This command retrieves all configuration group placements from a specified organization,
 displays them in a nicely formatted way, and allows you to see the placements in detail.
@@ -149,11 +173,13 @@ Examples:
 
 	ListNodesLongDesc = `Retrieve a comprehensive list of all available nodes in the system.
 These nodes can be allocated to your organization based on your requirements.
-You can use a query to filter the nodes using operators like >, =, !=, and < with the label values.
+You can use a query to filter the nodes by label values with =, !=, >, >=, < and <=, match lists with in [...],
+and combine conditions with and, or, not and parentheses.
 
 Examples:
 - cockpit list nodes --query 'labelKey >, =, !=, or < value'
-- cockpit list nodes --query 'memory-totalGB > 2'`
+- cockpit list nodes --query 'memory-totalGB > 2'
+- cockpit list nodes --query 'cpu-cores >= 4 and (memory-totalGB > 8 or disk-freeGB > 100) and region in [eu, us]'`
 
 	ListStandaloneConfigLongDesc = `This command retrieves a list of all standalone configurations for a given organization.
 
@@ -185,24 +211,59 @@ Example:
 	PutConfigGroupLongDesc = `This command sends a configuration group read from a file (JSON or YAML) to the server.
 It processes the file and uploads the configuration group, displaying the server's response in the same format as the input file.
 
+With --bump major, minor or patch, the version in the file is replaced by the next semantic version after the greater of the file's version
+and the existing versions of the group, e.g. v1.3.0 after v1.2.4 with --bump minor. The version in the file is kept when the group has
+no versions yet, and a new version that already exists is refused unless --allow-existing is given. Without --bump, the file is put as it is.
+
+Files given with --overlay are merged over the file in order: param sets are matched by name and params by key, replacing their values
+or adding them, and other fields replace those of the file. --set paramSet.key=value sets a single param after the overlays.
+With --values, the file and overlays are rendered as Go templates with the values first, e.g. {{ .db.host }}.
+Use 'cockpit render config' to print the merged configuration group without sending it.
+
 Example:
-- cockpit put config group --path 'path to yaml or JSON file'`
+- cockpit put config group --path 'path to yaml or JSON file'
+- cockpit put config group --path 'path to yaml or JSON file' --bump patch
+- cockpit put config group --path 'base.yaml' --overlay 'prod.yaml' --values 'vars.yaml' --set 'db.port=5433'`
 
 	LongLabelDesc = `This command allows you to add a new label to a specified node, enhancing node metadata.
 Provide a key-value pair to define the label. If the label already exists, its value will be updated to the new specified value.
 The command supports different types of values: strings, boolean, and floating-point numbers.
-The input format determines the appropriate type and URL for the request.
+The --type flag (string, float or bool) selects the type; without it, the value is stored as a float if it parses as one, then as a bool, and otherwise as a string.
+Keys are at most 63 letters, digits, '-', '_' and '.', starting and ending with a letter or digit.
 
 Examples:
 - cockpit put label --key 'env' --value 'production' --node-id 'nodeId' --org 'org'
 - cockpit put label --key 'active' --value 'true' --node-id 'nodeId' --org 'org'
-- cockpit put label --key 'cpu' --value '2.5' --node-id 'nodeId' --org 'org'`
+- cockpit put label --key 'cpu' --value '2.5' --node-id 'nodeId' --org 'org'
+- cockpit put label --key 'version' --value '1' --type string --node-id 'nodeId' --org 'org'`
 
 	PutStandaloneConfigLongDesc = `This command sends a standalone configuration read from a file (JSON or YAML) to the server.
 It processes the file and uploads the standalone configuration, displaying the server's response in the same format as the input file.
 
+With --bump major, minor or patch, the version in the file is replaced by the next semantic version after the greater of the file's version
+and the existing versions of the configuration, e.g. v1.3.0 after v1.2.4 with --bump minor. The version in the file is kept when the
+configuration has no versions yet, and a new version that already exists is refused unless --allow-existing is given. Without --bump, the file
+is put as it is.
+
+Files given with --overlay are merged over the file in order: params are matched by key, replacing their values or adding them,
+and other fields replace those of the file. --set key=value sets a single param after the overlays.
+With --values, the file and overlays are rendered as Go templates with the values first, e.g. {{ .db.host }}.
+Use 'cockpit render config' to print the merged configuration without sending it.
+
 Example:
-- cockpit put standalone config --path 'path to yaml or JSON file'`
+- cockpit put standalone config --path 'path to yaml or JSON file'
+- cockpit put standalone config --path 'path to yaml or JSON file' --bump patch
+- cockpit put standalone config --path 'base.yaml' --overlay 'prod.yaml' --set 'port=5433'`
+
+	RenderConfigLongDesc = `This command prints a configuration group or standalone configuration built from a file (JSON or YAML) the way
+'put config group' and 'put standalone config' build it, without sending it to the server.
+Files given with --overlay are merged over the file in order, matching param sets by name and params by key.
+--set sets a single param after the overlays, as paramSet.key=value for configuration groups and key=value for standalone configurations.
+With --values, the file and overlays are rendered as Go templates with the values first. The result is printed as YAML unless -o json is given.
+
+Example:
+- cockpit render config --path 'base.yaml' --overlay 'prod.yaml'
+- cockpit render config --path 'base.yaml' --values 'vars.yaml' --set 'db.port=5433' -o json`
 
 	ValidateSchemaVersionLongDesc = `This command validates a schema version with the given configuration.
 The user specifies the organization, schema name, version, and path to the YAML or JSON configuration file.
@@ -210,4 +271,142 @@ It reads the configuration file and validates the schema version against it.
 
 Example:
 - cockpit validate schema --org 'org' --schema-name 'schema' --version 'v1.0.0' --path '/path/to/config.yaml'`
+
+	UseContextLongDesc = `Sets the current context in the client config (~/.cockpit/config or $COCKPIT_CONFIG).
+All commands resolve the gateway, routes and defaults through the current context unless --context is given.
+
+Example:
+- cockpit config use-context staging`
+
+	GetContextsLongDesc = `Displays all contexts from the client config. The current context is marked with '*'.
+
+Example:
+- cockpit config get-contexts`
+
+	SetContextLongDesc = `Creates a context or modifies the fields given as flags on an existing one.
+A context holds the gateway address, the path to the gateway route configuration, the default organization and namespace,
+the logged in user, the credential store backend holding that user's token, the request timeout,
+the TLS settings (CA file, client certificate and key, skip-verify) used to reach the gateway
+and the URL of the metrics API, which defaults to port 8086 of the gateway host.
+The first context created becomes the current context.
+
+Examples:
+- cockpit config set-context dev --scheme http --host localhost --port 5555 --routes '/path/to/tools/config.yml' --org 'c12s' --namespace 'default'
+- cockpit config set-context prod --host gateway.example.com --scheme https --ca-file /etc/c12s/ca.pem --timeout 30s`
+
+	DeleteContextLongDesc = `Deletes a context from the client config.
+
+Example:
+- cockpit config delete-context staging`
+
+	LongLogoutDesc = `Deletes the stored token of the logged in user from the credential store and detaches the user from the current context.
+
+Example:
+- cockpit logout
+- cockpit logout --context staging`
+
+	LongWhoAmIDesc = `Displays the user of the current context together with the subject, organization, issuer and expiry decoded from the stored token.
+The token is decoded locally; no request is sent to the gateway.
+
+Example:
+- cockpit whoami
+- cockpit whoami --output json`
+
+	GetNodeLongDesc = `Displays the labels of a single node, looked up among the nodes of the organization and in the node pool.
+The organization defaults to the one of the current context.
+
+Example:
+- cockpit get node 'nodeID'
+- cockpit get node 'nodeID' --org 'c12s' -o yaml`
+
+	ListLabelsLongDesc = `Lists the labels of a node with the type of each value: string, float or bool.
+The node is looked up among the nodes of the organization given with --org, and then in the node pool.
+
+Example:
+- cockpit list labels --node-id 'nodeID'
+- cockpit list labels --node-id 'nodeID' --org 'org' -o json`
+
+	ApplyLongDesc = `Creates or updates the resources described in YAML or JSON manifest files.
+Every document has a kind field naming its resource: Namespace, App, Relation, Policy, Schema, ConfigGroup, StandaloneConfig, ConfigGroupPlacement or StandaloneConfigPlacement.
+The rest of the document is the file the matching create, put or place command reads; Schema documents hold the schema details with the schema inline under schema.
+Files may hold several documents separated by ---, directories are read file by file, and - reads stdin.
+Documents are applied in dependency order (namespaces, apps, relations, policies, schemas, configs, then placements) and each object is reported as created, updated, unchanged or exists.
+Config versions stored with different params are not overwritten and fail the command; bump the version instead.
+Applying stops at the first failure.
+
+Example:
+- cockpit apply -f resources.yaml
+- cockpit apply -f namespace.yaml -f configs/
+- cat resources.yaml | cockpit apply -f -`
+
+	DeleteLongDesc = `Deletes the resources described in the same YAML or JSON manifest files apply reads, or single resources through the subcommands.
+Documents are deleted in reverse dependency order (configs and schemas before apps and namespaces).
+Relations, policies and placements cannot be deleted through the gateway and are reported as skipped.
+The objects are listed before a confirmation prompt, which --yes skips. With --ignore-not-found, objects that do not exist are reported as not found instead of failing the command.
+Deleting stops at the first failure.
+
+Example:
+- cockpit delete -f resources.yaml
+- cockpit delete -f configs/ --ignore-not-found --yes`
+
+	ApplyLabelsLongDesc = `Puts and deletes labels on the nodes of an organization as listed in a YAML or JSON file.
+Each target selects nodes by their IDs or by a query and lists labels with an explicit type (string, float or bool) and keys to remove.
+Only the labels missing from a node or holding a different value are put, and only present keys are deleted. Several nodes are changed at the same time, up to --parallel.
+The command ends with a table of the labels put and deleted on every selected node.
+
+Example:
+- cockpit apply labels -p labels.yaml
+- cockpit apply labels -p labels.yaml --org 'org' --parallel 8
+
+File (labels.yaml):
+org: org
+targets:
+  - nodeIds: [nodeID1, nodeID2]
+    labels:
+      - {key: env, type: string, value: prod}
+      - {key: weight, type: float, value: 1.5}
+    remove: [legacy]
+  - query: 'cpu-cores > 4'
+    labels:
+      - {key: big, type: bool, value: true}`
+
+	ReleaseNodesLongDesc = `Returns nodes owned by an organization to the node pool, selected either by a query over their labels or by their IDs.
+The selected nodes are shown before a confirmation prompt, which --yes skips. The command ends with a summary of released and skipped nodes.
+
+Example:
+- cockpit release nodes --org 'org' --query 'env = test'
+- cockpit release nodes --org 'org' --node-id 'nodeID1,nodeID2' --yes`
+
+	DescribeNodeLongDesc = `Shows everything known about a node in one view: its labels, the organization owning it,
+the placement tasks of the config groups and standalone configs in the namespace that targeted it, and its latest metrics.
+The organization and namespace default to the ones of the current context. Parts that cannot be fetched are listed as warnings.
+
+Example:
+- cockpit describe node --node-id 'nodeID'
+- cockpit describe node --node-id 'nodeID' --org 'c12s' --namespace 'default' -o json`
+
+	HistoryConfigGroupLongDesc = `Lists the versions of a configuration group from oldest to newest with their creation time, and summarizes the params added, deleted and changed since the version before.
+Params are named paramSet.key; the wide output lists them.
+
+Example:
+- cockpit history config group --org 'c12s' --namespace 'default' --name 'app_config'
+- cockpit history config group --org 'c12s' --namespace 'default' --name 'app_config' -o wide`
+
+	HistoryStandaloneConfigLongDesc = `Lists the versions of a standalone configuration from oldest to newest with their creation time, and summarizes the params added, deleted and changed since the version before.
+
+Example:
+- cockpit history standalone config --org 'c12s' --namespace 'default' --name 'db_config'`
+
+	RollbackConfigGroupLongDesc = `Puts the param sets of an earlier configuration group version again under a new version, which must not exist yet.
+With --place, the new version is placed with the strategy the group was last placed with by cockpit on this machine.
+
+Example:
+- cockpit rollback config group --org 'c12s' --namespace 'default' --name 'app_config' --to 'v3' --as 'v6'
+- cockpit rollback config group --org 'c12s' --namespace 'default' --name 'app_config' --to 'v3' --as 'v6' --place`
+
+	RollbackStandaloneConfigLongDesc = `Puts the params of an earlier standalone configuration version again under a new version, which must not exist yet.
+With --place, the new version is placed with the strategy the configuration was last placed with by cockpit on this machine.
+
+Example:
+- cockpit rollback standalone config --org 'c12s' --namespace 'default' --name 'db_config' --to 'v3' --as 'v6' --place`
 )
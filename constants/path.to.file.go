@@ -1,19 +0,0 @@
-package constants
-
-const (
-	DiffConfigFilePathJSON           = "./response/config-group/config-group-diff.json"
-	DiffConfigFilePathYAML           = "./response/config-group/config-group-diff.yaml"
-	DiffStandaloneConfigFilePathJSON = "./response/config-group/standalone-config-diff.json"
-	DiffStandaloneConfigFilePathYAML = "./response/config-group/standalone-config-diff.yaml"
-	GetConfigGroupFilePathJSON       = "./response/config-group/get-config-group.json"
-	GetConfigGroupFilePathYAML       = "./response/config-group/get-config-group.yaml"
-	GetSchemaFilePathYAML            = "./response/schema/get-schema.yaml"
-	GetSchemaVersionFilePathYAML     = "./response/schema/get-schema-version.yaml"
-	ListConfigGroupFilePathJSON      = "./response/config-group/list-config-groups.json"
-	ListConfigGroupFilePathYAML      = "./response/config-group/list-config-groups.yaml"
-	ListStandaloneConfigFilePathJSON = "./response/standalone-config/list-standalone-configs.json"
-	ListStandaloneConfigFilePathYAML = "./response/standalone-config/list-standalone-configs.yaml"
-	GetStandaloneConfigFilePathJSON  = "./response/standalone-config/standalone-config.json"
-	GetStandaloneConfigFilePathYAML  = "./response/standalone-config/standalone-config.yaml"
-	ResponseDirPathJSON              = "./response/"
-)
@@ -33,4 +33,23 @@ const (
 	ShortLabelDesc                           = "Add a label to a node."
 	PutStandaloneConfigShortDesc             = "Saves standalone configuration"
 	ValidateSchemaVersionShortDesc           = "Validate a schema version"
+	ConfigShortDesc                          = "Manage client config contexts"
+	UseContextShortDesc                      = "Set the current context"
+	GetContextsShortDesc                     = "Display all configured contexts"
+	SetContextShortDesc                      = "Create or modify a context"
+	DeleteContextShortDesc                   = "Delete a context"
+	ShortLogoutDesc                          = "Logout from the current context"
+	ShortWhoAmIDesc                          = "Display the logged in user"
+	GetNodeShortDesc                         = "Display the labels of a node"
+	DescribeNodeShortDesc                    = "Show the labels, owner, placements and metrics of a node"
+	ReleaseNodesShortDesc                    = "Release nodes of an organization back to the node pool"
+	ListLabelsShortDesc                      = "List the labels of a node with their types"
+	ApplyShortDesc                           = "Create or update the resources described in manifest files"
+	DeleteShortDesc                          = "Delete resources, or the resources described in manifest files"
+	ApplyLabelsShortDesc                     = "Put and delete labels on many nodes from a file"
+	HistoryConfigGroupShortDesc              = "List the versions of a configuration group with their changes"
+	HistoryStandaloneConfigShortDesc         = "List the versions of a standalone configuration with their changes"
+	RollbackConfigGroupShortDesc             = "Put an earlier configuration group version again as a new version"
+	RollbackStandaloneConfigShortDesc        = "Put an earlier standalone configuration version again as a new version"
+	RenderConfigShortDesc                    = "Print a configuration merged from its file, overlays, values and params"
 )
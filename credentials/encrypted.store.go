@@ -0,0 +1,160 @@
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	encryptedFileName = "credentials.enc"
+	keyIterations     = 200000
+	keyLength         = 32
+	saltLength        = 16
+)
+
+// EncryptedFileStore keeps tokens in an AES-GCM encrypted file whose key is
+// derived from a user passphrase with PBKDF2-HMAC-SHA256.
+type EncryptedFileStore struct {
+	path       string
+	passphrase func() (string, error)
+	secret     string
+}
+
+type encryptedFile struct {
+	Salt  []byte `json:"salt"`
+	Nonce []byte `json:"nonce"`
+	Data  []byte `json:"data"`
+}
+
+func init() {
+	Register("encrypted", func(opts Options) (Store, error) {
+		if opts.Passphrase == nil {
+			return nil, errors.New("encrypted credential store requires a passphrase")
+		}
+		return &EncryptedFileStore{path: filepath.Join(opts.Dir, encryptedFileName), passphrase: opts.Passphrase}, nil
+	})
+}
+
+func (s *EncryptedFileStore) Get(key string) (string, error) {
+	tokens, err := s.load()
+	if err != nil {
+		return "", err
+	}
+
+	token, ok := tokens[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return token, nil
+}
+
+func (s *EncryptedFileStore) Set(key, token string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = token
+	return s.save(tokens)
+}
+
+func (s *EncryptedFileStore) Delete(key string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tokens[key]; !ok {
+		return ErrNotFound
+	}
+	delete(tokens, key)
+	return s.save(tokens)
+}
+
+func (s *EncryptedFileStore) load() (map[string]string, error) {
+	data, err := readPrivateFile(s.path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse credential store %s: %v", s.path, err)
+	}
+
+	gcm, err := s.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := gcm.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt credential store, wrong passphrase?")
+	}
+
+	tokens := map[string]string{}
+	if err := json.Unmarshal(plain, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to parse credential store %s: %v", s.path, err)
+	}
+	return tokens, nil
+}
+
+func (s *EncryptedFileStore) save(tokens map[string]string) error {
+	plain, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	file := encryptedFile{Salt: make([]byte, saltLength)}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+
+	gcm, err := s.cipher(file.Salt)
+	if err != nil {
+		return err
+	}
+
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Data = gcm.Seal(nil, file.Nonce, plain, nil)
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	return writePrivateFile(s.path, data)
+}
+
+func (s *EncryptedFileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if s.secret == "" {
+		secret, err := s.passphrase()
+		if err != nil {
+			return nil, err
+		}
+		if secret == "" {
+			return nil, errors.New("passphrase cannot be empty")
+		}
+		s.secret = secret
+	}
+
+	block, err := aes.NewCipher(pbkdf2.Key([]byte(s.secret), salt, keyIterations, keyLength, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,76 @@
+package credentials
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func openEncryptedStore(t *testing.T, dir, passphrase string) Store {
+	t.Helper()
+	store, err := Open("encrypted", Options{Dir: dir, Passphrase: func() (string, error) { return passphrase, nil }})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return store
+}
+
+func TestEncryptedStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	store := openEncryptedStore(t, dir, "correct horse")
+	if err := store.Set("gw/alice", "token-a"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := store.Set("gw/bob", "token-b"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, encryptedFileName))
+	if err != nil {
+		t.Fatalf("reading store file: %v", err)
+	}
+	if bytes.Contains(data, []byte("token-a")) {
+		t.Errorf("store file contains the token in plain text: %s", data)
+	}
+
+	reopened := openEncryptedStore(t, dir, "correct horse")
+	for key, want := range map[string]string{"gw/alice": "token-a", "gw/bob": "token-b"} {
+		got, err := reopened.Get(key)
+		if err != nil {
+			t.Fatalf("Get(%q): %v", key, err)
+		}
+		if got != want {
+			t.Errorf("Get(%q) = %q, want %q", key, got, want)
+		}
+	}
+
+	if err := reopened.Delete("gw/alice"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := openEncryptedStore(t, dir, "correct horse").Get("gw/alice"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEncryptedStoreWrongPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	if err := openEncryptedStore(t, dir, "correct horse").Set("gw/alice", "token-a"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	store := openEncryptedStore(t, dir, "battery staple")
+	if _, err := store.Get("gw/alice"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get with a wrong passphrase: err = %v, want a decryption error", err)
+	}
+	if err := store.Set("gw/bob", "token-b"); err == nil {
+		t.Error("Set with a wrong passphrase succeeded and would overwrite the store")
+	}
+}
+
+func TestEncryptedStoreEmptyPassphrase(t *testing.T) {
+	if err := openEncryptedStore(t, t.TempDir(), "").Set("gw/alice", "token-a"); err == nil {
+		t.Error("Set with an empty passphrase succeeded")
+	}
+}
@@ -0,0 +1,120 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+const plainFileName = "credentials"
+
+// FileStore keeps tokens as JSON in a file readable only by the owner.
+type FileStore struct {
+	path string
+}
+
+func init() {
+	Register("file", func(opts Options) (Store, error) {
+		return &FileStore{path: filepath.Join(opts.Dir, plainFileName)}, nil
+	})
+}
+
+func (s *FileStore) Get(key string) (string, error) {
+	tokens, err := s.load()
+	if err != nil {
+		return "", err
+	}
+
+	token, ok := tokens[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return token, nil
+}
+
+func (s *FileStore) Set(key, token string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = token
+	return s.save(tokens)
+}
+
+func (s *FileStore) Delete(key string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tokens[key]; !ok {
+		return ErrNotFound
+	}
+	delete(tokens, key)
+	return s.save(tokens)
+}
+
+func (s *FileStore) load() (map[string]string, error) {
+	data, err := readPrivateFile(s.path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := map[string]string{}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to parse credential store %s: %v", s.path, err)
+	}
+	return tokens, nil
+}
+
+func (s *FileStore) save(tokens map[string]string) error {
+	data, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writePrivateFile(s.path, data)
+}
+
+// readPrivateFile refuses to read credentials that other users could read too.
+func readPrivateFile(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("credential store %s has insecure permissions %v, run 'chmod 600 %s'", path, info.Mode().Perm(), path)
+	}
+	return os.ReadFile(path)
+}
+
+func writePrivateFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".credentials-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
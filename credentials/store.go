@@ -0,0 +1,58 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrNotFound = errors.New("no credentials stored")
+
+// Store keeps tokens keyed by gateway and username. Backends register a
+// Factory under a name so that a context can select them via credential-store.
+type Store interface {
+	Get(key string) (string, error)
+	Set(key, token string) error
+	Delete(key string) error
+}
+
+type Options struct {
+	Dir        string
+	Passphrase func() (string, error)
+}
+
+type Factory func(opts Options) (Store, error)
+
+const DefaultBackend = "file"
+
+var backends = map[string]Factory{}
+
+func Register(name string, factory Factory) {
+	backends[name] = factory
+}
+
+func Open(name string, opts Options) (Store, error) {
+	if name == "" {
+		name = DefaultBackend
+	}
+
+	factory, ok := backends[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown credential store %q, available: %s", name, strings.Join(Backends(), ", "))
+	}
+	return factory(opts)
+}
+
+func Backends() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Key(gateway, username string) string {
+	return gateway + "|" + username
+}
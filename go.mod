@@ -3,17 +3,18 @@ module github.com/c12s/cockpit
 go 1.21
 
 require (
+	github.com/cheggaaa/pb/v3 v3.1.5
 	github.com/fatih/color v1.17.0
 	github.com/jedib0t/go-pretty/v6 v6.5.9
 	github.com/rodaine/table v1.2.0
 	github.com/spf13/cobra v1.7.0
+	golang.org/x/crypto v0.23.0
 	golang.org/x/term v0.20.0
 	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/VividCortex/ewma v1.2.0 // indirect
-	github.com/cheggaaa/pb/v3 v3.1.5 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
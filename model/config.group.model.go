@@ -7,12 +7,14 @@ type Query struct {
 }
 
 type PlaceConfigGroupPlacementsRequest struct {
-	Config   ConfigReference `json:"config" yaml:"config"`
-	Strategy struct {
-		Name       string  `json:"name" yaml:"name"`
-		Query      []Query `json:"query" yaml:"query"`
-		Percentage int     `json:"percentage" yaml:"percentage"`
-	} `json:"strategy" yaml:"strategy"`
+	Config   ConfigReference   `json:"config" yaml:"config"`
+	Strategy PlacementStrategy `json:"strategy" yaml:"strategy"`
+}
+
+type PlacementStrategy struct {
+	Name       string  `json:"name" yaml:"name"`
+	Query      []Query `json:"query" yaml:"query"`
+	Percentage int     `json:"percentage" yaml:"percentage"`
 }
 
 type ParamSet struct {
@@ -29,6 +31,11 @@ type ConfigGroup struct {
 	ParamSets    []ParamSet `json:"paramSets" yaml:"paramSets"`
 }
 
+type ListConfigRequest struct {
+	Organization string `json:"organization" yaml:"organization"`
+	Namespace    string `json:"namespace" yaml:"namespace"`
+}
+
 type ConfigGroupsResponse struct {
 	Groups []ConfigGroup `json:"groups" yaml:"groups"`
 }
@@ -75,7 +82,9 @@ type ConfigGroupDiff struct {
 }
 
 type ConfigGroupDiffResponse struct {
-	Diffs map[string]struct {
-		Diffs []ConfigGroupDiff `json:"diffs" yaml:"diffs"`
-	} `json:"diffs" yaml:"diffs"`
+	Diffs map[string]ParamSetDiff `json:"diffs" yaml:"diffs"`
+}
+
+type ParamSetDiff struct {
+	Diffs []ConfigGroupDiff `json:"diffs" yaml:"diffs"`
 }
@@ -0,0 +1,16 @@
+package model
+
+// ConfigVersionHistory is a version of a config group or standalone config
+// with the params changed since the version before it. Params of config
+// groups are named paramSet.key.
+type ConfigVersionHistory struct {
+	Organization string   `json:"organization" yaml:"organization"`
+	Namespace    string   `json:"namespace" yaml:"namespace"`
+	Name         string   `json:"name" yaml:"name"`
+	Version      string   `json:"version" yaml:"version"`
+	CreatedAt    string   `json:"createdAt" yaml:"createdAt"`
+	Params       int      `json:"params" yaml:"params"`
+	Added        []string `json:"added" yaml:"added"`
+	Deleted      []string `json:"deleted" yaml:"deleted"`
+	Changed      []string `json:"changed" yaml:"changed"`
+}
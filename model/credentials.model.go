@@ -29,3 +29,25 @@ type HTTPRequestConfig struct {
 	Token       string
 	Timeout     time.Duration
 }
+
+type TokenClaims struct {
+	Subject   string `json:"sub" yaml:"subject"`
+	Org       string `json:"org,omitempty" yaml:"org,omitempty"`
+	Issuer    string `json:"iss,omitempty" yaml:"issuer,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty" yaml:"expiresAt,omitempty"`
+	IssuedAt  int64  `json:"iat,omitempty" yaml:"issuedAt,omitempty"`
+}
+
+func (c TokenClaims) Expiry() (time.Time, bool) {
+	if c.ExpiresAt == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(c.ExpiresAt, 0), true
+}
+
+type WhoAmI struct {
+	Context string      `json:"context" yaml:"context"`
+	Gateway string      `json:"gateway" yaml:"gateway"`
+	User    string      `json:"user" yaml:"user"`
+	Claims  TokenClaims `json:"claims" yaml:"claims"`
+}
@@ -16,3 +16,52 @@ type DeleteLabelInput struct {
 	NodeID   string `json:"nodeId"`
 	Org      string `json:"org"`
 }
+
+// LabelsManifest lists the labels wanted on the nodes of an organization,
+// as read by apply labels.
+type LabelsManifest struct {
+	Org     string        `json:"org" yaml:"org"`
+	Targets []LabelTarget `json:"targets" yaml:"targets"`
+}
+
+// LabelTarget selects nodes by ID or by query. Its labels are put on the
+// nodes missing or holding a different value, and the Remove keys are deleted
+// from the nodes having them.
+type LabelTarget struct {
+	NodeIDs []string     `json:"nodeIds,omitempty" yaml:"nodeIds"`
+	Query   string       `json:"query,omitempty" yaml:"query"`
+	Labels  []TypedLabel `json:"labels,omitempty" yaml:"labels"`
+	Remove  []string     `json:"remove,omitempty" yaml:"remove"`
+}
+
+// TypedLabel is a label with an explicit value type: string, float64 or bool.
+type TypedLabel struct {
+	Key   string      `json:"key" yaml:"key"`
+	Type  string      `json:"type" yaml:"type"`
+	Value interface{} `json:"value" yaml:"value"`
+}
+
+// NodeLabelChanges are the label puts and deletes a node needs.
+type NodeLabelChanges struct {
+	NodeID    string
+	Put       []TypedLabel
+	Delete    []string
+	Unchanged int
+}
+
+// LabelChangeResult reports the label changes applied to a node. Error is set
+// when a change failed; the changes after it were not attempted.
+type LabelChangeResult struct {
+	NodeID    string   `json:"nodeId"`
+	Put       []string `json:"put"`
+	Deleted   []string `json:"deleted"`
+	Unchanged int      `json:"unchanged"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// NodeLabels lists the labels of a node with the types of their values.
+type NodeLabels struct {
+	NodeID string       `json:"nodeId" yaml:"nodeId"`
+	Org    string       `json:"org" yaml:"org"`
+	Labels []TypedLabel `json:"labels" yaml:"labels"`
+}
@@ -0,0 +1,57 @@
+package model
+
+// Kinds of manifest documents, in the order apply creates them so that
+// namespaces exist before their apps and schemas before the configs.
+const (
+	KindNamespace                 = "Namespace"
+	KindApp                       = "App"
+	KindRelation                  = "Relation"
+	KindPolicy                    = "Policy"
+	KindSchema                    = "Schema"
+	KindConfigGroup               = "ConfigGroup"
+	KindStandaloneConfig          = "StandaloneConfig"
+	KindConfigGroupPlacement      = "ConfigGroupPlacement"
+	KindStandaloneConfigPlacement = "StandaloneConfigPlacement"
+)
+
+var ManifestKinds = []string{
+	KindNamespace,
+	KindApp,
+	KindRelation,
+	KindPolicy,
+	KindSchema,
+	KindConfigGroup,
+	KindStandaloneConfig,
+	KindConfigGroupPlacement,
+	KindStandaloneConfigPlacement,
+}
+
+// Statuses reported for manifest objects.
+const (
+	StatusCreated   = "created"
+	StatusUpdated   = "updated"
+	StatusUnchanged = "unchanged"
+	StatusExists    = "exists"
+	StatusDeleted   = "deleted"
+	StatusNotFound  = "not found"
+	StatusSkipped   = "skipped"
+	StatusFailed    = "failed"
+)
+
+// DryRunSuffix marks the statuses of objects a dry run did not change.
+const DryRunSuffix = " (dry run)"
+
+// Manifest is a document of a manifest file. Spec holds the document without
+// its kind field, in the shape the matching put or create command reads.
+type Manifest struct {
+	Kind   string
+	Source string
+	Spec   map[string]interface{}
+}
+
+// SchemaDocument is the spec of a Schema manifest: the schema details with
+// the schema itself inline.
+type SchemaDocument struct {
+	SchemaDetails
+	Schema string `json:"schema" yaml:"schema"`
+}
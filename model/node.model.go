@@ -1,10 +1,23 @@
 package model
 
+type QueryNodesRequest struct {
+	Query []NodeQuery `json:"query"`
+}
+
 type ClaimNodesRequest struct {
 	Org   string      `json:"org,omitempty"`
 	Query []NodeQuery `json:"query,omitempty"`
 }
 
+type ReleaseNodesRequest struct {
+	Org     string   `json:"org"`
+	NodeIDs []string `json:"nodeIds"`
+}
+
+type ReleaseNodesResponse struct {
+	Nodes []Node `json:"nodes"`
+}
+
 type NodeQuery struct {
 	LabelKey string      `json:"labelKey"`
 	ShouldBe string      `json:"shouldBe"`
@@ -28,3 +41,32 @@ type NodesResponse struct {
 type ClaimNodesResponse struct {
 	Nodes []Node `json:"node"`
 }
+
+// NodeDescription gathers what is known about a single node. Owner is empty
+// for nodes still in the node pool. Warnings list the parts that could not
+// be fetched.
+type NodeDescription struct {
+	Node       Node            `json:"node"`
+	Owner      string          `json:"owner"`
+	Placements []NodePlacement `json:"placements"`
+	Metrics    MetricResponse  `json:"metrics"`
+	Warnings   []string        `json:"warnings,omitempty"`
+}
+
+// NodePlacement is a placement task of a configuration that targeted a node.
+type NodePlacement struct {
+	Kind   string `json:"kind"`
+	Config string `json:"config"`
+	Task   Task   `json:"task"`
+}
+
+// OwnershipSummary reports the nodes a claim or release changed. Skipped
+// lists the selected nodes the gateway did not change, and Unselected the
+// changed nodes that were never selected.
+type OwnershipSummary struct {
+	Action     string   `json:"action"`
+	Org        string   `json:"org"`
+	Nodes      []Node   `json:"nodes"`
+	Skipped    []string `json:"skipped"`
+	Unselected []string `json:"unselected"`
+}
@@ -0,0 +1,16 @@
+package model
+
+type OrgReference struct {
+	OrgId string `json:"orgId" yaml:"orgId"`
+}
+
+type NamespaceReference struct {
+	OrgId string `json:"orgId" yaml:"orgId"`
+	Name  string `json:"name" yaml:"name"`
+}
+
+type AppReference struct {
+	OrgId     string `json:"orgId" yaml:"orgId"`
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+}
@@ -0,0 +1,9 @@
+package model
+
+// Result reports the outcome of a request the gateway answers without
+// returning the affected resource.
+type Result struct {
+	Kind   string `json:"kind" yaml:"kind"`
+	Name   string `json:"name" yaml:"name"`
+	Status string `json:"status" yaml:"status"`
+}
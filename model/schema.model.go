@@ -11,6 +11,16 @@ type SchemaDetailsRequest struct {
 	SchemaDetails SchemaDetails `json:"schema_details" yaml:"schema_details"`
 }
 
+type SaveSchemaRequest struct {
+	SchemaDetails SchemaDetails `json:"schema_details" yaml:"schema_details"`
+	Schema        string        `json:"schema" yaml:"schema"`
+}
+
+type ValidateConfigurationRequest struct {
+	SchemaDetails SchemaDetails `json:"schema_details" yaml:"schema_details"`
+	Configuration string        `json:"configuration" yaml:"configuration"`
+}
+
 type SchemaData struct {
 	Schema       string `json:"schema" yaml:"schema"`
 	CreationTime string `json:"creationTime" yaml:"creationTime"`
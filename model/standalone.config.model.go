@@ -2,7 +2,7 @@ package model
 
 type StandaloneConfig struct {
 	Organization string  `json:"organization" yaml:"organization"`
-	Namespace string          `json:"namespace" yaml:"namespace"`
+	Namespace    string  `json:"namespace" yaml:"namespace"`
 	Name         string  `json:"name" yaml:"name"`
 	Version      string  `json:"version" yaml:"version"`
 	CreatedAt    string  `json:"createdAt" yaml:"createdAt"`
@@ -2,105 +2,73 @@ package render
 
 import (
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"sort"
 
 	"github.com/c12s/cockpit/model"
 )
 
-func RenderTasksTabWriter(tasks []model.Task) {
-	if len(tasks) == 0 {
-		fmt.Println("No tasks were found.")
-		return
+func TasksTable(tasks []model.Task) Table {
+	table := Table{
+		Kind:    "tasks",
+		Columns: []Column{{Header: "ID"}, {Header: "Node"}, {Header: "Status"}, {Header: "Accepted At"}, {Header: "Resolved At"}},
+		Names:   []string{},
+		Empty:   len(tasks) == 0,
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintln(w, "ID\tNode\tStatus\tAccepted At\tResolved At\t")
-
 	for _, task := range tasks {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n",
-			task.ID,
-			task.Node,
-			task.Status,
-			task.AcceptedAt,
-			task.ResolvedAt)
+		table.Rows = append(table.Rows, []string{task.ID, task.Node, task.Status, task.AcceptedAt, task.ResolvedAt})
+		table.Names = append(table.Names, "task/"+task.ID)
 	}
+	return table
 }
 
-func RenderConfigGroupsTabWriter(groups []model.ConfigGroup) {
-	if len(groups) == 0 {
-		fmt.Println("No configuration groups were found.")
-		return
+func ConfigGroupsTable(groups []model.ConfigGroup) Table {
+	table := Table{
+		Kind:    "configuration groups",
+		Columns: []Column{{Header: "Organization"}, {Header: "Namespace"}, {Header: "Name"}, {Header: "Version"}, {Header: "Created At"}, {Header: "Param Set Name"}, {Header: "Params"}},
+		Names:   []string{},
+		Empty:   len(groups) == 0,
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintln(w, "Organization\tNamespace\tName\tVersion\tCreated At\tParam Set Name\tParams\t")
-
 	for _, group := range groups {
 		for _, paramSet := range group.ParamSets {
 			for _, param := range paramSet.ParamSet {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s=%s\t\n", group.Organization, group.Namespace, group.Name, group.Version, group.CreatedAt, paramSet.Name, param.Key, param.Value)
+				table.Rows = append(table.Rows, []string{group.Organization, group.Namespace, group.Name, group.Version, group.CreatedAt, paramSet.Name, param.Key + "=" + param.Value})
 			}
 		}
+		table.Names = append(table.Names, "config-group/"+configName(group.Organization, group.Namespace, group.Name, group.Version))
 	}
+	return table
 }
 
-func RenderConfigGroupTabWriter(group model.ConfigGroup) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintln(w, "Organization\tNamespace\tName\tVersion\tCreated At\tParam Set Name\tParams\t")
-
-	for _, paramSet := range group.ParamSets {
-		for _, param := range paramSet.ParamSet {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s=%s\t\n", group.Organization, group.Namespace, group.Name, group.Version, group.CreatedAt, paramSet.Name, param.Key, param.Value)
-		}
-	}
-}
-
-func RenderConfigGroupDiffsTabWriter(diffResponse model.ConfigGroupDiffResponse) {
-	noDiffsFound := true
-
-	for _, diffSet := range diffResponse.Diffs {
-		if len(diffSet.Diffs) > 0 {
-			noDiffsFound = false
-			break
-		}
+func ConfigGroupDiffsTable(diffResponse model.ConfigGroupDiffResponse) Table {
+	table := Table{
+		Kind:    "diffs",
+		Columns: []Column{{Header: "Category"}, {Header: "Key"}, {Header: "Value"}, {Header: "Change"}},
 	}
 
-	if noDiffsFound {
-		fmt.Println("No diffs were found.")
-		return
+	categories := make([]string, 0, len(diffResponse.Diffs))
+	for category := range diffResponse.Diffs {
+		categories = append(categories, category)
 	}
+	sort.Strings(categories)
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintln(w, "Category\tKey\tValue\tChange\t")
-
-	for category, diffSet := range diffResponse.Diffs {
-		for _, diff := range diffSet.Diffs {
+	for _, category := range categories {
+		for _, diff := range diffResponse.Diffs[category].Diffs {
 			switch diff.Type {
 			case "deletion":
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n",
-					category,
-					diff.Diff.Key,
-					diff.Diff.Value,
-					"-",
-				)
+				table.Rows = append(table.Rows, []string{category, diff.Diff.Key, diff.Diff.Value, "-"})
 			case "addition":
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s%s\t\n",
-					category,
-					diff.Diff.Key,
-					diff.Diff.Value,
-					diff.Diff.NewValue,
-					"+",
-				)
+				table.Rows = append(table.Rows, []string{category, diff.Diff.Key, diff.Diff.Value + diff.Diff.NewValue, "+"})
+			case "replacement":
+				table.Rows = append(table.Rows, []string{category, diff.Diff.Key, fmt.Sprintf("%s -> %s", diff.Diff.OldValue, diff.Diff.NewValue), "->"})
 			}
 		}
 	}
+	table.Empty = len(table.Rows) == 0
+	return table
+}
+
+func configName(organization, namespace, name, version string) string {
+	return fmt.Sprintf("%s/%s/%s@%s", organization, namespace, name, version)
 }
@@ -0,0 +1,55 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// ConfigHistoryTable lists config versions with a summary of their changes,
+// naming them kind/org/namespace/name@version in the name output.
+func ConfigHistoryTable(kind string, history []model.ConfigVersionHistory) Table {
+	table := Table{
+		Kind: "versions",
+		Columns: []Column{
+			{Header: "Version"}, {Header: "Created At"}, {Header: "Params"}, {Header: "Changes"},
+			{Header: "Added", Wide: true}, {Header: "Deleted", Wide: true}, {Header: "Changed", Wide: true},
+		},
+		Names: []string{},
+		Empty: len(history) == 0,
+	}
+
+	for i, entry := range history {
+		changes := historyChanges(entry)
+		if i == 0 {
+			changes = "first version"
+		}
+		table.Rows = append(table.Rows, []string{
+			entry.Version, entry.CreatedAt, fmt.Sprint(entry.Params), changes,
+			keyList(entry.Added), keyList(entry.Deleted), keyList(entry.Changed),
+		})
+		table.Names = append(table.Names, kind+"/"+configName(entry.Organization, entry.Namespace, entry.Name, entry.Version))
+	}
+	if len(history) > 0 {
+		first := history[0]
+		table.Title = fmt.Sprintf("%s/%s/%s", first.Organization, first.Namespace, first.Name)
+	}
+	return table
+}
+
+func historyChanges(entry model.ConfigVersionHistory) string {
+	var changes []string
+	for _, change := range []struct {
+		count int
+		verb  string
+	}{{len(entry.Added), "added"}, {len(entry.Deleted), "deleted"}, {len(entry.Changed), "changed"}} {
+		if change.count > 0 {
+			changes = append(changes, fmt.Sprintf("%d %s", change.count, change.verb))
+		}
+	}
+	if len(changes) == 0 {
+		return "no changes"
+	}
+	return strings.Join(changes, ", ")
+}
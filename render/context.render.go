@@ -0,0 +1,51 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/c12s/cockpit/config"
+)
+
+func ContextsTable(clientConfig config.ClientConfig) Table {
+	table := Table{
+		Kind: "contexts",
+		Columns: []Column{
+			{Header: "Current"},
+			{Header: "Name"},
+			{Header: "Gateway"},
+			{Header: "Routes"},
+			{Header: "Organization"},
+			{Header: "Namespace"},
+			{Header: "User", Wide: true},
+			{Header: "Credential Store", Wide: true},
+		},
+		Names: []string{},
+		Empty: len(clientConfig.Contexts) == 0,
+	}
+
+	for _, ctx := range clientConfig.Contexts {
+		marker := ""
+		if ctx.Name == clientConfig.CurrentContext {
+			marker = "*"
+		}
+		table.Rows = append(table.Rows, []string{marker, ctx.Name, formatGateway(ctx.Gateway), ctx.Routes, ctx.Organization, ctx.Namespace, ctx.User, ctx.CredentialStore})
+		table.Names = append(table.Names, "context/"+ctx.Name)
+	}
+	return table
+}
+
+func formatGateway(gateway config.Endpoint) string {
+	scheme, host := gateway.Scheme, gateway.Host
+	if scheme == "" {
+		scheme = "http"
+	}
+	if host == "" {
+		host = "localhost"
+	}
+
+	address := fmt.Sprintf("%s://%s", scheme, host)
+	if gateway.Port != "" {
+		address += ":" + gateway.Port
+	}
+	return address + gateway.Route
+}
@@ -0,0 +1,107 @@
+package render
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/c12s/cockpit/model"
+	"github.com/fatih/color"
+)
+
+type diffSection struct {
+	Name  string
+	Lines []diffLine
+}
+
+type diffLine struct {
+	Sign  string
+	Key   string
+	Value string
+}
+
+var (
+	diffHeaderColor  = color.New(color.Bold)
+	diffSectionColor = color.New(color.FgCyan)
+	diffRemoveColor  = color.New(color.FgRed)
+	diffAddColor     = color.New(color.FgGreen)
+)
+
+// PrintConfigGroupDiff writes the diff of config group from against to. The
+// table and wide outputs show it as a colored unified diff with a section per
+// param set; the other outputs print the diff response.
+func PrintConfigGroupDiff(from, to string, diff model.ConfigGroupDiffResponse) error {
+	if !TableOutput() {
+		return Print(diff)
+	}
+
+	names := make([]string, 0, len(diff.Diffs))
+	for name := range diff.Diffs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sections := make([]diffSection, 0, len(names))
+	for _, name := range names {
+		section := diffSection{Name: name}
+		for _, change := range diff.Diffs[name].Diffs {
+			section.Lines = append(section.Lines, diffLines(change.Type, change.Diff.Key, change.Diff.Value+change.Diff.NewValue, change.Diff.OldValue, change.Diff.NewValue)...)
+		}
+		sections = append(sections, section)
+	}
+
+	printUnifiedDiff(from, to, sections)
+	return SaveResponse(diff)
+}
+
+// PrintStandaloneConfigDiff writes the diff of standalone config from against
+// to like PrintConfigGroupDiff, with a single section for its params.
+func PrintStandaloneConfigDiff(from, to string, diff model.StandaloneConfigDiffResponse) error {
+	if !TableOutput() {
+		return Print(diff)
+	}
+
+	var sections []diffSection
+	if len(diff.Diffs) > 0 {
+		section := diffSection{Name: "paramSet"}
+		for _, change := range diff.Diffs {
+			section.Lines = append(section.Lines, diffLines(change.Type, change.Diff["key"], change.Diff["value"], change.Diff["old_value"], change.Diff["new_value"])...)
+		}
+		sections = append(sections, section)
+	}
+
+	printUnifiedDiff(from, to, sections)
+	return SaveResponse(diff)
+}
+
+func diffLines(changeType, key, value, oldValue, newValue string) []diffLine {
+	switch changeType {
+	case "deletion":
+		return []diffLine{{Sign: "-", Key: key, Value: value}}
+	case "addition":
+		return []diffLine{{Sign: "+", Key: key, Value: value}}
+	case "replacement":
+		return []diffLine{{Sign: "-", Key: key, Value: oldValue}, {Sign: "+", Key: key, Value: newValue}}
+	}
+	return nil
+}
+
+func printUnifiedDiff(from, to string, sections []diffSection) {
+	if len(sections) == 0 {
+		fmt.Fprintf(os.Stderr, "No differences between %s and %s.\n", from, to)
+		return
+	}
+
+	diffHeaderColor.Fprintf(stdout, "--- %s\n", from)
+	diffHeaderColor.Fprintf(stdout, "+++ %s\n", to)
+	for _, section := range sections {
+		diffSectionColor.Fprintf(stdout, "@@ %s @@\n", section.Name)
+		for _, line := range section.Lines {
+			lineColor := diffAddColor
+			if line.Sign == "-" {
+				lineColor = diffRemoveColor
+			}
+			lineColor.Fprintf(stdout, "%s%s: %s\n", line.Sign, line.Key, line.Value)
+		}
+	}
+}
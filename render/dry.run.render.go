@@ -0,0 +1,54 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/c12s/cockpit/client"
+)
+
+func RenderDryRunRequest(request client.DryRunRequest) {
+	fmt.Printf("Action:  %s\n", request.Action)
+	fmt.Printf("Method:  %s\n", request.Method)
+	fmt.Printf("URL:     %s\n", request.URL)
+	fmt.Println("Headers:")
+	for _, name := range sortedHeaderNames(request.Headers) {
+		fmt.Printf("  %s: %s\n", name, request.Headers[name])
+	}
+	if len(request.Body) > 0 {
+		fmt.Println("Body:")
+		fmt.Println(string(request.Body))
+	}
+}
+
+// RenderDryRunCurl prints a curl command sending the same request. The token
+// is left as an environment variable reference for the shell to expand.
+func RenderDryRunCurl(request client.DryRunRequest) {
+	parts := []string{"curl", "-X", request.Method, shellQuote(request.URL)}
+	for _, name := range sortedHeaderNames(request.Headers) {
+		header := name + ": " + request.Headers[name]
+		if strings.Contains(header, client.TokenPlaceholder) {
+			parts = append(parts, "-H", `"`+header+`"`)
+		} else {
+			parts = append(parts, "-H", shellQuote(header))
+		}
+	}
+	if len(request.Body) > 0 {
+		parts = append(parts, "--data-raw", shellQuote(string(request.Body)))
+	}
+	fmt.Println(strings.Join(parts, " "))
+}
+
+func sortedHeaderNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,664 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a template of literal text and {action} blocks, following the
+// kubectl jsonpath syntax: fields (.name, ['name'] or ['a','b']), indexes
+// ([0], [-1] or [0,2]), slices ([1:3], [::2]), wildcards ([*] or .*),
+// recursive descent (..name), filters ([?(@.key == "value")]), the current
+// object ({.} or {@}), the root ($), {range expression}...{end} loops and
+// quoted literals ({"\n"}). Several results of one expression are space
+// separated. Paths that select nothing fail, as do out-of-range indexes.
+type jsonPath struct {
+	nodes []jsonPathNode
+}
+
+type jsonPathNode struct {
+	literal    string
+	expression *jsonPathExpression
+	// body is set for {range} nodes, whose expression results it is
+	// executed with in turn.
+	body    []jsonPathNode
+	isRange bool
+}
+
+type jsonPathExpression struct {
+	text     string
+	fromRoot bool
+	steps    []jsonPathStep
+}
+
+type jsonPathStep struct {
+	fields    []string
+	indexes   []int
+	slice     *jsonPathSlice
+	filter    *jsonPathFilter
+	wildcard  bool
+	recursive bool
+}
+
+type jsonPathSlice struct {
+	start, end *int
+	step       int
+}
+
+type jsonPathFilter struct {
+	left, right jsonPathOperand
+	operator    string
+}
+
+type jsonPathOperand struct {
+	path    *jsonPathExpression
+	literal interface{}
+}
+
+var jsonPathOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func parseJSONPath(template string) (*jsonPath, error) {
+	if strings.TrimSpace(template) == "" {
+		return nil, fmt.Errorf("jsonpath output requires an expression, e.g. -o jsonpath='{.name}'")
+	}
+	if !strings.Contains(template, "{") {
+		template = "{" + template + "}"
+	}
+
+	// stack holds the node lists being built, one per open {range}.
+	stack := [][]jsonPathNode{nil}
+	var ranges []jsonPathNode
+	rest := template
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{literal: rest})
+			break
+		}
+		if open > 0 {
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{literal: rest[:open]})
+		}
+
+		end := closingIndex(rest[open+1:], '}', false)
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed '{' in %q", template)
+		}
+		action := strings.TrimSpace(rest[open+1 : open+1+end])
+		rest = rest[open+1+end+1:]
+
+		switch {
+		case strings.HasPrefix(action, `"`) || strings.HasPrefix(action, "'"):
+			literal, err := unquoteJSONPath(action)
+			if err != nil {
+				return nil, fmt.Errorf("invalid literal %s", action)
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{literal: literal})
+		case action == "end":
+			if len(ranges) == 0 {
+				return nil, fmt.Errorf("{end} without {range} in %q", template)
+			}
+			node := ranges[len(ranges)-1]
+			node.body = stack[len(stack)-1]
+			ranges, stack = ranges[:len(ranges)-1], stack[:len(stack)-1]
+			stack[len(stack)-1] = append(stack[len(stack)-1], node)
+		case action == "range" || strings.HasPrefix(action, "range "):
+			expression, err := parseJSONPathExpression(strings.TrimSpace(strings.TrimPrefix(action, "range")))
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, jsonPathNode{expression: expression, isRange: true})
+			stack = append(stack, nil)
+		default:
+			expression, err := parseJSONPathExpression(action)
+			if err != nil {
+				return nil, err
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], jsonPathNode{expression: expression})
+		}
+	}
+	if len(ranges) > 0 {
+		return nil, fmt.Errorf("{range %s} without {end} in %q", ranges[len(ranges)-1].expression.text, template)
+	}
+	return &jsonPath{nodes: stack[0]}, nil
+}
+
+// closingIndex returns the index of the first close byte in value outside
+// quotes and, when nested is set, outside brackets and parentheses, or -1.
+func closingIndex(value string, close byte, nested bool) int {
+	depth := 0
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\'':
+			i = closingQuote(value, i)
+		case c == close && depth == 0:
+			return i
+		case nested && (c == '[' || c == '('):
+			depth++
+		case nested && (c == ']' || c == ')'):
+			depth--
+		}
+	}
+	return -1
+}
+
+// closingQuote returns the index of the quote closing the one at start, or
+// the end of value.
+func closingQuote(value string, start int) int {
+	for i := start + 1; i < len(value); i++ {
+		if value[i] == '\\' {
+			i++
+		} else if value[i] == value[start] {
+			return i
+		}
+	}
+	return len(value)
+}
+
+func unquoteJSONPath(value string) (string, error) {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return value[1 : len(value)-1], nil
+	}
+	return strconv.Unquote(value)
+}
+
+func parseJSONPathExpression(text string) (*jsonPathExpression, error) {
+	expression := &jsonPathExpression{text: text}
+	rest := text
+	switch {
+	case strings.HasPrefix(rest, "$"):
+		expression.fromRoot = true
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "@"):
+		rest = rest[1:]
+	}
+
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			field, remaining := readJSONPathField(rest[2:])
+			if field == "" {
+				return nil, fmt.Errorf("missing field after '..' in %q", text)
+			}
+			step := jsonPathStep{recursive: true, fields: []string{field}}
+			if field == "*" {
+				step = jsonPathStep{recursive: true, wildcard: true}
+			}
+			expression.steps = append(expression.steps, step)
+			rest = remaining
+		case rest[0] == '.':
+			field, remaining := readJSONPathField(rest[1:])
+			if field == "*" {
+				expression.steps = append(expression.steps, jsonPathStep{wildcard: true})
+			} else if field != "" {
+				expression.steps = append(expression.steps, jsonPathStep{fields: []string{field}})
+			}
+			rest = remaining
+		case rest[0] == '[':
+			end := closingIndex(rest[1:], ']', true)
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed '[' in %q", text)
+			}
+			step, err := parseJSONPathSubscript(strings.TrimSpace(rest[1 : end+1]))
+			if err != nil {
+				return nil, fmt.Errorf("%v in %q", err, text)
+			}
+			expression.steps = append(expression.steps, step)
+			rest = rest[end+2:]
+		case len(expression.steps) == 0 && !expression.fromRoot:
+			field, remaining := readJSONPathField(rest)
+			expression.steps = append(expression.steps, jsonPathStep{fields: []string{field}})
+			rest = remaining
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", rest, text)
+		}
+	}
+	return expression, nil
+}
+
+func readJSONPathField(value string) (string, string) {
+	end := strings.IndexAny(value, ".[")
+	if end < 0 {
+		return value, ""
+	}
+	return value[:end], value[end:]
+}
+
+func parseJSONPathSubscript(subscript string) (jsonPathStep, error) {
+	switch {
+	case subscript == "*":
+		return jsonPathStep{wildcard: true}, nil
+	case strings.HasPrefix(subscript, "?(") && strings.HasSuffix(subscript, ")"):
+		filter, err := parseJSONPathFilter(strings.TrimSpace(subscript[2 : len(subscript)-1]))
+		if err != nil {
+			return jsonPathStep{}, err
+		}
+		return jsonPathStep{filter: filter}, nil
+	case strings.HasPrefix(subscript, "'") || strings.HasPrefix(subscript, `"`):
+		var step jsonPathStep
+		for _, item := range splitJSONPathUnion(subscript) {
+			field, err := unquoteJSONPath(item)
+			if err != nil {
+				return jsonPathStep{}, fmt.Errorf("invalid field [%s]", subscript)
+			}
+			step.fields = append(step.fields, field)
+		}
+		return step, nil
+	case strings.Contains(subscript, ":"):
+		return parseJSONPathSlice(subscript)
+	}
+
+	var step jsonPathStep
+	for _, item := range splitJSONPathUnion(subscript) {
+		index, err := strconv.Atoi(item)
+		if err != nil {
+			return jsonPathStep{}, fmt.Errorf("invalid subscript [%s]", subscript)
+		}
+		step.indexes = append(step.indexes, index)
+	}
+	return step, nil
+}
+
+func splitJSONPathUnion(subscript string) []string {
+	var items []string
+	for {
+		end := closingIndex(subscript, ',', true)
+		if end < 0 {
+			return append(items, strings.TrimSpace(subscript))
+		}
+		items = append(items, strings.TrimSpace(subscript[:end]))
+		subscript = subscript[end+1:]
+	}
+}
+
+func parseJSONPathSlice(subscript string) (jsonPathStep, error) {
+	parts := strings.Split(subscript, ":")
+	if len(parts) > 3 {
+		return jsonPathStep{}, fmt.Errorf("invalid slice [%s]", subscript)
+	}
+	slice := &jsonPathSlice{step: 1}
+	bounds := []**int{&slice.start, &slice.end}
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return jsonPathStep{}, fmt.Errorf("invalid slice [%s]", subscript)
+		}
+		if i == 2 {
+			if n <= 0 {
+				return jsonPathStep{}, fmt.Errorf("slice step must be positive in [%s]", subscript)
+			}
+			slice.step = n
+			continue
+		}
+		*bounds[i] = &n
+	}
+	return jsonPathStep{slice: slice}, nil
+}
+
+func parseJSONPathFilter(text string) (*jsonPathFilter, error) {
+	for i := 0; i < len(text); i++ {
+		if text[i] == '"' || text[i] == '\'' {
+			i = closingQuote(text, i)
+			continue
+		}
+		for _, operator := range jsonPathOperators {
+			if !strings.HasPrefix(text[i:], operator) {
+				continue
+			}
+			left, err := parseJSONPathOperand(strings.TrimSpace(text[:i]))
+			if err != nil {
+				return nil, err
+			}
+			right, err := parseJSONPathOperand(strings.TrimSpace(text[i+len(operator):]))
+			if err != nil {
+				return nil, err
+			}
+			return &jsonPathFilter{left: left, right: right, operator: operator}, nil
+		}
+	}
+
+	operand, err := parseJSONPathOperand(text)
+	if err != nil {
+		return nil, err
+	}
+	if operand.path == nil {
+		return nil, fmt.Errorf("invalid filter ?(%s)", text)
+	}
+	return &jsonPathFilter{left: operand}, nil
+}
+
+func parseJSONPathOperand(text string) (jsonPathOperand, error) {
+	switch {
+	case strings.HasPrefix(text, "@") || strings.HasPrefix(text, "$"):
+		path, err := parseJSONPathExpression(text)
+		return jsonPathOperand{path: path}, err
+	case strings.HasPrefix(text, "'") || strings.HasPrefix(text, `"`):
+		value, err := unquoteJSONPath(text)
+		if err != nil {
+			return jsonPathOperand{}, fmt.Errorf("invalid literal %s", text)
+		}
+		return jsonPathOperand{literal: value}, nil
+	case text == "true" || text == "false":
+		return jsonPathOperand{literal: text == "true"}, nil
+	case text == "null":
+		return jsonPathOperand{}, nil
+	}
+	if _, err := strconv.ParseFloat(text, 64); err != nil {
+		return jsonPathOperand{}, fmt.Errorf("invalid filter operand %q", text)
+	}
+	return jsonPathOperand{literal: json.Number(text)}, nil
+}
+
+func (p *jsonPath) execute(data interface{}) (string, error) {
+	var out strings.Builder
+	if err := executeJSONPathNodes(&out, p.nodes, data, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func executeJSONPathNodes(out *strings.Builder, nodes []jsonPathNode, root, current interface{}) error {
+	for _, node := range nodes {
+		if node.expression == nil {
+			out.WriteString(node.literal)
+			continue
+		}
+
+		values, err := node.expression.evaluate(root, current)
+		if err != nil {
+			return err
+		}
+		if node.isRange {
+			for _, value := range values {
+				if err := executeJSONPathNodes(out, node.body, root, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for i, value := range values {
+			if i > 0 {
+				out.WriteByte(' ')
+			}
+			text, err := formatJSONPathValue(value)
+			if err != nil {
+				return err
+			}
+			out.WriteString(text)
+		}
+	}
+	return nil
+}
+
+func (e *jsonPathExpression) evaluate(root, current interface{}) ([]interface{}, error) {
+	values := []interface{}{current}
+	if e.fromRoot {
+		values = []interface{}{root}
+	}
+
+	for _, step := range e.steps {
+		var next []interface{}
+		for _, value := range values {
+			results, err := step.apply(value, root)
+			if err != nil {
+				return nil, fmt.Errorf("%v in %s", err, e.text)
+			}
+			next = append(next, results...)
+		}
+		if len(next) == 0 && len(values) > 0 && len(step.fields) > 0 {
+			return nil, fmt.Errorf("%s is not found in %s", strings.Join(step.fields, ", "), e.text)
+		}
+		values = next
+	}
+	return values, nil
+}
+
+func (s jsonPathStep) apply(value, root interface{}) ([]interface{}, error) {
+	switch {
+	case s.recursive && s.wildcard:
+		return jsonPathDescendants(value), nil
+	case s.recursive:
+		return findJSONPathField(value, s.fields[0]), nil
+	case s.wildcard:
+		return jsonPathChildren(value), nil
+	case s.filter != nil:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot filter %s, it is not an array", jsonPathKind(value))
+		}
+		var matches []interface{}
+		for _, item := range items {
+			if s.filter.matches(item, root) {
+				matches = append(matches, item)
+			}
+		}
+		return matches, nil
+	case s.slice != nil:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot slice %s, it is not an array", jsonPathKind(value))
+		}
+		return s.slice.apply(items), nil
+	case s.indexes != nil:
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot index %s, it is not an array", jsonPathKind(value))
+		}
+		results := make([]interface{}, 0, len(s.indexes))
+		for _, index := range s.indexes {
+			position := index
+			if position < 0 {
+				position += len(items)
+			}
+			if position < 0 || position >= len(items) {
+				return nil, fmt.Errorf("array index out of bounds: index %d, length %d", index, len(items))
+			}
+			results = append(results, items[position])
+		}
+		return results, nil
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	var results []interface{}
+	for _, field := range s.fields {
+		if result, ok := object[field]; ok {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func (s *jsonPathSlice) apply(items []interface{}) []interface{} {
+	bound := func(value *int, fallback int) int {
+		if value == nil {
+			return fallback
+		}
+		n := *value
+		if n < 0 {
+			n += len(items)
+		}
+		if n < 0 {
+			return 0
+		}
+		if n > len(items) {
+			return len(items)
+		}
+		return n
+	}
+
+	var results []interface{}
+	for i := bound(s.start, 0); i < bound(s.end, len(items)); i += s.step {
+		results = append(results, items[i])
+	}
+	return results
+}
+
+// matches reports whether item passes the filter. Paths that select nothing
+// do not match rather than fail, so that filters skip incomplete items.
+func (f *jsonPathFilter) matches(item, root interface{}) bool {
+	left, ok := f.left.value(item, root)
+	if !ok {
+		return false
+	}
+	if f.operator == "" {
+		return true
+	}
+	right, ok := f.right.value(item, root)
+	if !ok {
+		return false
+	}
+	return compareJSONPathValues(left, right, f.operator)
+}
+
+func (o jsonPathOperand) value(item, root interface{}) (interface{}, bool) {
+	if o.path == nil {
+		return o.literal, true
+	}
+	values, err := o.path.evaluate(root, item)
+	if err != nil || len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+func compareJSONPathValues(left, right interface{}, operator string) bool {
+	order, comparable := 0, false
+	if leftNumber, ok := jsonPathNumber(left); ok {
+		if rightNumber, ok := jsonPathNumber(right); ok {
+			order, comparable = compareFloats(leftNumber, rightNumber), true
+		}
+	}
+	if leftText, ok := left.(string); ok {
+		if rightText, ok := right.(string); ok {
+			order, comparable = strings.Compare(leftText, rightText), true
+		}
+	}
+
+	switch operator {
+	case "==":
+		if comparable {
+			return order == 0
+		}
+		return reflect.DeepEqual(left, right)
+	case "!=":
+		if comparable {
+			return order != 0
+		}
+		return !reflect.DeepEqual(left, right)
+	case "<":
+		return comparable && order < 0
+	case "<=":
+		return comparable && order <= 0
+	case ">":
+		return comparable && order > 0
+	case ">=":
+		return comparable && order >= 0
+	}
+	return false
+}
+
+func jsonPathNumber(value interface{}) (float64, bool) {
+	if number, ok := value.(json.Number); ok {
+		f, err := number.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func jsonPathKind(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case string:
+		return "a string"
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a bool"
+	case nil:
+		return "null"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func jsonPathChildren(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		children := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			children = append(children, v[key])
+		}
+		return children
+	}
+	return nil
+}
+
+func jsonPathDescendants(value interface{}) []interface{} {
+	var found []interface{}
+	for _, child := range jsonPathChildren(value) {
+		found = append(found, child)
+		found = append(found, jsonPathDescendants(child)...)
+	}
+	return found
+}
+
+func findJSONPathField(value interface{}, field string) []interface{} {
+	var found []interface{}
+	if object, ok := value.(map[string]interface{}); ok {
+		if match, ok := object[field]; ok {
+			found = append(found, match)
+		}
+	}
+	for _, child := range jsonPathChildren(value) {
+		found = append(found, findJSONPathField(child, field)...)
+	}
+	return found
+}
+
+func formatJSONPathValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
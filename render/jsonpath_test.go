@@ -0,0 +1,166 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const jsonPathNodes = `[
+  {"id": "n1", "org": "c12s", "labels": [{"key": "env", "value": "prod"}, {"key": "cpu-cores", "value": 4}]},
+  {"id": "n2", "org": "c12s", "labels": [{"key": "env", "value": "dev"}, {"key": "cpu-cores", "value": 2}]},
+  {"id": "n3", "labels": [{"key": "cpu-cores", "value": 16}]}
+]`
+
+func decodeJSONPathData(t *testing.T, data string) interface{} {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		t.Fatalf("decoding test data: %v", err)
+	}
+	return generic
+}
+
+func TestJSONPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"field", `{[0].id}`, "n1"},
+		{"template without braces", `[0].id`, "n1"},
+		{"bracket field", `{[0]['id']}`, "n1"},
+		{"field union", `{[0]['id','org']}`, "n1 c12s"},
+		{"index", `{[1].id}`, "n2"},
+		{"negative index", `{[-1].id}`, "n3"},
+		{"index union", `{[0,2].id}`, "n1 n3"},
+		{"wildcard", `{[*].id}`, "n1 n2 n3"},
+		{"dot wildcard", `{[0].labels[0].*}`, "env prod"},
+		{"slice", `{[0:2].id}`, "n1 n2"},
+		{"open slice", `{[1:].id}`, "n2 n3"},
+		{"negative slice", `{[-2:].id}`, "n2 n3"},
+		{"slice with step", `{[::2].id}`, "n1 n3"},
+		{"slice past the end", `{[1:10].id}`, "n2 n3"},
+		{"recursive descent", `{..key}`, "env cpu-cores env cpu-cores cpu-cores"},
+		{"root", `{$[0].id}`, "n1"},
+		{"current object", `{[0].labels[0]}`, `{"key":"env","value":"prod"}`},
+		{"several expressions", `{[2].labels[0].value}{" "}{[2].id}`, "16 n3"},
+		{"whole document", `{.}`, strings.Join(strings.Fields(`[{"id":"n1","labels":[{"key":"env","value":"prod"},{"key":"cpu-cores","value":4}],"org":"c12s"},{"id":"n2","labels":[{"key":"env","value":"dev"},{"key":"cpu-cores","value":2}],"org":"c12s"},{"id":"n3","labels":[{"key":"cpu-cores","value":16}]}]`), "")},
+		{"at", `{[0].labels[0].key}{@[1].id}`, "envn2"},
+		{"literals", `{[0].id}{"\t"}{[1].id}{'\n'}`, "n1\tn2\\n"},
+		{"text around expressions", `id={[0].id}, org={[0].org}`, "id=n1, org=c12s"},
+		{"range", `{range [*]}{.id}{"\n"}{end}`, "n1\nn2\nn3\n"},
+		{"range with current object", `{range [*].labels[0]}{@.key}={.value};{end}`, "env=prod;env=dev;cpu-cores=16;"},
+		{"nested range", `{range [0:2]}{.id}:{range .labels[*]}{" "}{.key}{end}{"\n"}{end}`, "n1: env cpu-cores\nn2: env cpu-cores\n"},
+		{"range with root", `{range [0:2]}{.id}/{$[2].id} {end}`, "n1/n3 n2/n3 "},
+		{"range over nothing", `{range [?(@.org == "other")]}{.id}{end}done`, "done"},
+		{"filter equals", `{[?(@.org == "c12s")].id}`, "n1 n2"},
+		{"filter single quotes", `{[?(@.org=='c12s')].id}`, "n1 n2"},
+		{"filter not equals", `{[?(@.id != "n2")].id}`, "n1 n3"},
+		{"filter exists", `{[?(@.org)].id}`, "n1 n2"},
+		{"filter on numbers", `{..labels[?(@.value > 2)].value}`, "4 16"},
+		{"filter numbers numerically", `{..labels[?(@.value >= 16)].value}`, "16"},
+		{"filter less than", `{..labels[?(@.value < 4)].value}`, "2"},
+		{"filter nested path", `{[?(@.labels[0].value == "prod")].id}`, "n1"},
+		{"filter with brackets in string", `{[?(@.id == "]")].id}done`, "done"},
+		{"filter in range", `{range [?(@.org == "c12s")]}{.id} {end}`, "n1 n2 "},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := parseJSONPath(test.template)
+			if err != nil {
+				t.Fatalf("parseJSONPath(%q): %v", test.template, err)
+			}
+			got, err := path.execute(decodeJSONPathData(t, jsonPathNodes))
+			if err != nil {
+				t.Fatalf("execute(%q): %v", test.template, err)
+			}
+			if got != test.want {
+				t.Errorf("execute(%q) = %q, want %q", test.template, got, test.want)
+			}
+		})
+	}
+}
+
+func TestJSONPathParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"empty", ``, "requires an expression"},
+		{"unclosed brace", `{.id`, "unclosed '{'"},
+		{"unclosed bracket", `{[0.id}`, "unclosed '['"},
+		{"range without end", `{range [*]}{.id}`, "without {end}"},
+		{"end without range", `{.id}{end}`, "{end} without {range}"},
+		{"invalid subscript", `{[x]}`, "invalid subscript"},
+		{"invalid slice", `{[1:2:3:4]}`, "invalid slice"},
+		{"zero slice step", `{[::0]}`, "slice step must be positive"},
+		{"invalid filter operand", `{[?(@.a == prod)]}`, "invalid filter operand"},
+		{"filter without path", `{[?("a")]}`, "invalid filter"},
+		{"invalid literal", `{"\q"}`, "invalid literal"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseJSONPath(test.template)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("parseJSONPath(%q) error = %v, want it to contain %q", test.template, err, test.want)
+			}
+		})
+	}
+}
+
+func TestJSONPathExecuteErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"missing field", `{[0].missing}`, "missing is not found"},
+		{"missing field in range", `{range [*]}{.missing}{end}`, "missing is not found"},
+		{"missing range field", `{range .items[*]}{.id}{end}`, "items is not found"},
+		{"index out of range", `{[5].id}`, "array index out of bounds: index 5, length 3"},
+		{"negative index out of range", `{[-4].id}`, "array index out of bounds: index -4, length 3"},
+		{"index on an object", `{[0].org[0]}`, "cannot index a string"},
+		{"filter on an object", `{[0][?(@.id)]}`, "cannot filter an object"},
+		{"missing recursive field", `{..missing}`, "missing is not found"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := parseJSONPath(test.template)
+			if err != nil {
+				t.Fatalf("parseJSONPath(%q): %v", test.template, err)
+			}
+			_, err = path.execute(decodeJSONPathData(t, jsonPathNodes))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("execute(%q) error = %v, want it to contain %q", test.template, err, test.want)
+			}
+		})
+	}
+}
+
+func TestJSONPathOutput(t *testing.T) {
+	var out bytes.Buffer
+	original := stdout
+	stdout = &out
+	defer func() { stdout = original }()
+
+	p := printer{}
+	path, err := parseJSONPath(`{range [*]}{.name}{"\n"}{end}`)
+	if err != nil {
+		t.Fatalf("parseJSONPath: %v", err)
+	}
+	p.jsonPath = path
+	if err := p.printJSONPath([]map[string]string{{"name": "a"}, {"name": "b"}}); err != nil {
+		t.Fatalf("printJSONPath: %v", err)
+	}
+	if out.String() != "a\nb\n" {
+		t.Errorf("output = %q, want %q", out.String(), "a\nb\n")
+	}
+}
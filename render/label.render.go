@@ -0,0 +1,87 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// TypedLabelsTable lists the labels of a node with the types of their values.
+func TypedLabelsTable(labels model.NodeLabels) Table {
+	table := Table{
+		Kind:    "labels",
+		Columns: []Column{{Header: "Key"}, {Header: "Type"}, {Header: "Value"}, {Header: "Node ID", Wide: true}, {Header: "Org", Wide: true}},
+		Names:   []string{},
+		Empty:   len(labels.Labels) == 0,
+	}
+
+	for _, label := range labels.Labels {
+		table.Rows = append(table.Rows, []string{label.Key, label.Type, fmt.Sprint(label.Value), labels.NodeID, labels.Org})
+		table.Names = append(table.Names, "label/"+label.Key)
+	}
+	return table
+}
+
+func LabelChangesTable(results []model.LabelChangeResult) Table {
+	table := Table{
+		Kind: "nodes",
+		Columns: []Column{
+			{Header: "Node ID"},
+			{Header: "Put"},
+			{Header: "Deleted"},
+			{Header: "Unchanged"},
+			{Header: "Result"},
+		},
+		Names: []string{},
+		Empty: len(results) == 0,
+	}
+
+	var changed, put, deleted, failed int
+	for _, result := range results {
+		status := "unchanged"
+		switch {
+		case result.Error != "":
+			status = "error: " + result.Error
+			failed++
+		case len(result.Put)+len(result.Deleted) > 0:
+			status = "changed"
+		}
+		if len(result.Put)+len(result.Deleted) > 0 {
+			changed++
+		}
+		put += len(result.Put)
+		deleted += len(result.Deleted)
+
+		table.Rows = append(table.Rows, []string{
+			result.NodeID,
+			keyList(result.Put),
+			keyList(result.Deleted),
+			strconv.Itoa(result.Unchanged),
+			status,
+		})
+		table.Names = append(table.Names, "node/"+result.NodeID)
+	}
+
+	table.Footer = fmt.Sprintf("Changed %d of %s: %d %s put, %d deleted", changed, NodeCount(len(results)), put, labelWord(put), deleted)
+	if failed > 0 {
+		table.Footer += fmt.Sprintf(", %d failed", failed)
+	}
+	table.Footer += "."
+	return table
+}
+
+func keyList(keys []string) string {
+	if len(keys) == 0 {
+		return "-"
+	}
+	return strings.Join(keys, ", ")
+}
+
+func labelWord(count int) string {
+	if count == 1 {
+		return "label"
+	}
+	return "labels"
+}
@@ -2,22 +2,26 @@ package render
 
 import (
 	"fmt"
-	"os"
 	"sort"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/c12s/cockpit/model"
 	"github.com/c12s/cockpit/utils"
 )
 
-func RenderNodeMetrics(metrics model.MetricResponse, sortBy string, infraType string) {
-	nodeMetrics := metrics.FilterNodeMetrics()
-
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
+var nodeMetricsColumns = []Column{
+	{Header: "Service"},
+	{Header: "Metric"},
+	{Header: "Total"},
+	{Header: "Used"},
+	{Header: "Available"},
+	{Header: "Network Receive"},
+	{Header: "Network Transmit"},
+	{Header: "Bandwidth"},
+}
 
-	fmt.Fprintf(w, "Service\tMetric\tTotal\tUsed\tAvailable\tNetwork Receive\tNetwork Transmit\tBandwidth\n")
+func NodeMetricsTable(metrics model.MetricResponse, infraType string) Table {
+	nodeMetrics := metrics.FilterNodeMetrics()
 
 	metricsMap := make(map[string]map[string]float64)
 
@@ -39,13 +43,20 @@ func RenderNodeMetrics(metrics model.MetricResponse, sortBy string, infraType st
 		}
 	}
 
-	printNodeMetrics(w, infraType, "cpu", nil, "%", metricsMap["custom_node_cpu_usage_percentage"])
-	printNodeMetrics(w, infraType, "disk", metricsMap["custom_node_disk_total_gb"], "GB", metricsMap["custom_node_disk_usage_gb"])
-	printMemoryMetrics(w, infraType, "memory", metricsMap["custom_node_ram_total_mb"], metricsMap["custom_node_ram_available_mb"], "MB")
-	printNetworkMetrics(w, infraType, "network", metricsMap["custom_node_network_receive_mb"], metricsMap["custom_node_network_transmit_mb"], "MB")
+	return Table{
+		Kind:    "metrics",
+		Columns: nodeMetricsColumns,
+		Rows: [][]string{
+			nodeMetricsRow(infraType, "cpu", nil, "%", metricsMap["custom_node_cpu_usage_percentage"]),
+			nodeMetricsRow(infraType, "disk", metricsMap["custom_node_disk_total_gb"], "GB", metricsMap["custom_node_disk_usage_gb"]),
+			memoryMetricsRow(infraType, "memory", metricsMap["custom_node_ram_total_mb"], metricsMap["custom_node_ram_available_mb"], "MB"),
+			networkMetricsRow(infraType, "network", metricsMap["custom_node_network_receive_mb"], metricsMap["custom_node_network_transmit_mb"], "MB"),
+		},
+		Empty: len(nodeMetrics) == 0,
+	}
 }
 
-func RenderServiceMetrics(metrics model.MetricResponse, sortBy string, cluster bool) {
+func ServiceMetricsTable(metrics model.MetricResponse, sortBy string, cluster bool) Table {
 	serviceMetrics := metrics.FilterServiceMetrics()
 
 	sort.Slice(serviceMetrics, func(i, j int) bool {
@@ -65,11 +76,6 @@ func RenderServiceMetrics(metrics model.MetricResponse, sortBy string, cluster b
 		}
 	})
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintf(w, "Service\tCPU\tTotal Memory\tUsed Memory\tDisk Usage\tNetwork Receive\tNetwork Transmit\tBandwidth\n")
-
 	serviceMap := make(map[string]map[string]float64)
 
 	for _, data := range serviceMetrics {
@@ -128,6 +134,21 @@ func RenderServiceMetrics(metrics model.MetricResponse, sortBy string, cluster b
 		}
 	})
 
+	table := Table{
+		Kind: "service metrics",
+		Columns: []Column{
+			{Header: "Service"},
+			{Header: "CPU"},
+			{Header: "Total Memory"},
+			{Header: "Used Memory"},
+			{Header: "Disk Usage"},
+			{Header: "Network Receive"},
+			{Header: "Network Transmit"},
+			{Header: "Bandwidth"},
+		},
+		Empty: len(services) == 0,
+	}
+
 	for _, service := range services {
 		cpu := fmt.Sprintf("%.2f %%", serviceMap[service]["cpu"])
 		usedMemory := fmt.Sprintf("%.2f MB", serviceMap[service]["used_memory"])
@@ -135,11 +156,12 @@ func RenderServiceMetrics(metrics model.MetricResponse, sortBy string, cluster b
 		networkReceive := fmt.Sprintf("%.4f MB", serviceMap[service]["network_receive"])
 		networkTransmit := fmt.Sprintf("%.4f MB", serviceMap[service]["network_transmit"])
 		bandwidth := fmt.Sprintf("%.4f MB", serviceMap[service]["network_receive"]+serviceMap[service]["network_transmit"])
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", service, cpu, "N/A", usedMemory, diskUsage, networkReceive, networkTransmit, bandwidth)
+		table.Rows = append(table.Rows, []string{service, cpu, "N/A", usedMemory, diskUsage, networkReceive, networkTransmit, bandwidth})
 	}
+	return table
 }
 
-func printNodeMetrics(w *tabwriter.Writer, service, metric string, totalValues map[string]float64, unit string, usedValues ...map[string]float64) {
+func nodeMetricsRow(service, metric string, totalValues map[string]float64, unit string, usedValues ...map[string]float64) []string {
 	var total, used, networkReceive, networkTransmit float64
 	if totalValues != nil {
 		total = totalValues["total"]
@@ -155,31 +177,29 @@ func printNodeMetrics(w *tabwriter.Writer, service, metric string, totalValues m
 	available := total - used
 	bandwidth := networkReceive + networkTransmit
 
-	if metric == "cpu" {
-		fmt.Fprintf(w, "%s\t%s\t-\t%.2f %s\t-\t-\t-\t-\n", service, metric, used, unit)
-	} else if metric == "disk" {
-		fmt.Fprintf(w, "%s\t%s\t%.4f %s\t%.2f %s\t%.2f %s\t-\t-\t-\n", service, metric, total, unit, used, unit, available, unit)
-	} else {
-		if total == 0 {
-			fmt.Fprintf(w, "%s\t%s\t0.0000 %s\t0.0000 %s\t0.0000 %s\t-\t-\t-\n", service, metric, unit, unit, unit)
-		} else {
-			fmt.Fprintf(w, "%s\t%s\t%.2f %s\t%.2f %s\t%.2f %s\t%.4f %s\t%.4f %s\t%.4f %s\n", service, metric, total, unit, used, unit, available, unit, networkReceive, unit, networkTransmit, unit, bandwidth, unit)
-		}
+	switch {
+	case metric == "cpu":
+		return []string{service, metric, "-", fmt.Sprintf("%.2f %s", used, unit), "-", "-", "-", "-"}
+	case metric == "disk":
+		return []string{service, metric, fmt.Sprintf("%.4f %s", total, unit), fmt.Sprintf("%.2f %s", used, unit), fmt.Sprintf("%.2f %s", available, unit), "-", "-", "-"}
+	case total == 0:
+		return []string{service, metric, "0.0000 " + unit, "0.0000 " + unit, "0.0000 " + unit, "-", "-", "-"}
 	}
+	return []string{service, metric, fmt.Sprintf("%.2f %s", total, unit), fmt.Sprintf("%.2f %s", used, unit), fmt.Sprintf("%.2f %s", available, unit), fmt.Sprintf("%.4f %s", networkReceive, unit), fmt.Sprintf("%.4f %s", networkTransmit, unit), fmt.Sprintf("%.4f %s", bandwidth, unit)}
 }
 
-func printMemoryMetrics(w *tabwriter.Writer, service, metric string, totalValues map[string]float64, availableValues map[string]float64, unit string) {
+func memoryMetricsRow(service, metric string, totalValues map[string]float64, availableValues map[string]float64, unit string) []string {
 	total := totalValues["total"]
 	available := availableValues["used"]
 	used := total - available
 
-	fmt.Fprintf(w, "%s\t%s\t%.2f %s\t%.2f %s\t%.2f %s\t-\t-\t-\n", service, metric, total, unit, used, unit, available, unit)
+	return []string{service, metric, fmt.Sprintf("%.2f %s", total, unit), fmt.Sprintf("%.2f %s", used, unit), fmt.Sprintf("%.2f %s", available, unit), "-", "-", "-"}
 }
 
-func printNetworkMetrics(w *tabwriter.Writer, service, metric string, receiveValues map[string]float64, transmitValues map[string]float64, unit string) {
+func networkMetricsRow(service, metric string, receiveValues map[string]float64, transmitValues map[string]float64, unit string) []string {
 	receive := receiveValues["network_receive"]
 	transmit := transmitValues["network_transmit"]
 	bandwidth := receive + transmit
 
-	fmt.Fprintf(w, "%s\t%s\t-\t-\t-\t%.4f %s\t%.4f %s\t%.4f %s\n", service, metric, receive, unit, transmit, unit, bandwidth, unit)
+	return []string{service, metric, "-", "-", "-", fmt.Sprintf("%.4f %s", receive, unit), fmt.Sprintf("%.4f %s", transmit, unit), fmt.Sprintf("%.4f %s", bandwidth, unit)}
 }
@@ -3,10 +3,9 @@ package render
 import (
 	"fmt"
 	"github.com/c12s/cockpit/model"
-	"os"
+	"github.com/c12s/cockpit/utils"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 )
 
 func RenderNodes(nodes []model.Node) {
@@ -24,61 +23,233 @@ func RenderNodes(nodes []model.Node) {
 	}
 }
 
-func RenderNode(node model.Node) {
-	fmt.Printf("Node ID: %s\n", node.ID)
-	fmt.Println(strings.Repeat("-", 45))
-	for _, label := range node.Labels {
-		fmt.Printf("  - %s: %s\n", label.Key, label.Value)
+func NodesTable(nodes []model.Node) Table {
+	table := Table{
+		Kind: "nodes",
+		Columns: []Column{
+			{Header: "Node ID"},
+			{Header: "CPU Cores"},
+			{Header: "Avg Clock Speed (MHz)"},
+			{Header: "Avg Cache (KB)"},
+			{Header: "Memory (GB)"},
+			{Header: "Disk Total (GB)"},
+			{Header: "Disk Free (GB)"},
+			{Header: "Org", Wide: true},
+			{Header: "Labels", Wide: true},
+		},
+		Names:  []string{},
+		Empty:  len(nodes) == 0,
+		Footer: NodesFooter(nodes, len(nodes)),
 	}
-	fmt.Println(strings.Repeat("-", 45))
-}
-
-func RenderNodesTabWriter(nodes []model.Node) {
-	if len(nodes) == 0 {
-		fmt.Println("No nodes were found.")
-		return
-	}
-
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintf(w, "Node ID\tCPU Cores\tAvg Clock Speed (MHz)\tAvg Cache (KB)\tMemory (GB)\tDisk Total (GB)\tDisk Free (GB)\n")
 
 	for _, node := range nodes {
-		var cpuCores, totalMhz, totalCache, memoryTotalGB, diskTotalGB, diskFreeGB float64
-		var coreCount int
+		var totalMhz, totalCache float64
+		var mhzCount, cacheCount int
 
 		for _, label := range node.Labels {
-			value, ok := label.Value.(string)
-			if !ok {
+			value, err := strconv.ParseFloat(fmt.Sprint(label.Value), 64)
+			if err != nil {
 				continue
 			}
-			switch label.Key {
-			case "cpu-cores":
-				cpuCores, _ = strconv.ParseFloat(value, 64)
-			case "memory-totalGB":
-				memoryTotalGB, _ = strconv.ParseFloat(value, 64)
-			case "disk-totalGB":
-				diskTotalGB, _ = strconv.ParseFloat(value, 64)
-			case "disk-freeGB":
-				diskFreeGB, _ = strconv.ParseFloat(value, 64)
-			}
-
 			if strings.Contains(label.Key, "mhz") {
-				mhz, _ := strconv.ParseFloat(value, 64)
-				totalMhz += mhz
-				coreCount++
+				totalMhz += value
+				mhzCount++
 			}
-
 			if strings.Contains(label.Key, "cacheKB") {
-				cache, _ := strconv.ParseFloat(value, 64)
-				totalCache += cache
+				totalCache += value
+				cacheCount++
+			}
+		}
+
+		table.Rows = append(table.Rows, []string{
+			node.ID,
+			formatFloat(nodeNumber(node, "cpu-cores")),
+			formatAverage(totalMhz, mhzCount),
+			formatAverage(totalCache, cacheCount),
+			formatFloat(nodeNumber(node, "memory-totalGB")),
+			formatFloat(nodeNumber(node, "disk-totalGB")),
+			formatFloat(nodeNumber(node, "disk-freeGB")),
+			node.Org,
+			strconv.Itoa(len(node.Labels)),
+		})
+		table.Names = append(table.Names, nodeName(node))
+	}
+	return table
+}
+
+// NodeColumnsTable lists nodes with one column per label key. Nodes without
+// a label show <none> in its column.
+func NodeColumnsTable(nodes []model.Node, keys []string) Table {
+	table := Table{
+		Kind:    "nodes",
+		Columns: []Column{{Header: "Node ID"}},
+		Names:   []string{},
+		Empty:   len(nodes) == 0,
+		Footer:  NodesFooter(nodes, len(nodes)),
+	}
+	for _, key := range keys {
+		table.Columns = append(table.Columns, Column{Header: key})
+	}
+
+	for _, node := range nodes {
+		row := []string{node.ID}
+		for _, key := range keys {
+			value, ok := utils.NodeLabel(node, key)
+			if !ok {
+				value = "<none>"
 			}
+			row = append(row, value)
+		}
+		table.Rows = append(table.Rows, row)
+		table.Names = append(table.Names, nodeName(node))
+	}
+	return table
+}
+
+// NodesFooter sums up the listed nodes, mentioning the number of matching
+// nodes when it is larger, e.g. because of --limit.
+func NodesFooter(nodes []model.Node, matching int) string {
+	var cores, memory float64
+	for _, node := range nodes {
+		cores += nodeNumber(node, "cpu-cores")
+		memory += nodeNumber(node, "memory-totalGB")
+	}
+
+	count := strconv.Itoa(len(nodes))
+	if matching > len(nodes) {
+		count += " of " + strconv.Itoa(matching)
+	}
+	noun := "nodes"
+	if matching == 1 {
+		noun = "node"
+	}
+	return fmt.Sprintf("Total: %s %s, %s cores, %s GB memory", count, noun, strconv.FormatFloat(cores, 'f', -1, 64), formatFloat(memory))
+}
+
+func nodeNumber(node model.Node, key string) float64 {
+	value, _ := utils.NodeLabel(node, key)
+	return utils.StringToFloat(value)
+}
+
+// NodeLabelsTable lists the labels of a single node.
+func NodeLabelsTable(node model.Node) Table {
+	table := Table{
+		Kind:    "labels",
+		Columns: []Column{{Header: "Node ID"}, {Header: "Key"}, {Header: "Value"}, {Header: "Org", Wide: true}},
+		Names:   []string{nodeName(node)},
+	}
+
+	for _, label := range node.Labels {
+		table.Rows = append(table.Rows, []string{node.ID, label.Key, fmt.Sprint(label.Value), node.Org})
+	}
+	return table
+}
+
+// NodeDescriptionTables shows a node description as sections for its
+// overview, labels, placements, metrics and the parts that could not be fetched.
+func NodeDescriptionTables(description model.NodeDescription) []Table {
+	node := description.Node
+	owner := description.Owner
+	if owner == "" {
+		owner = "<none> (available in the node pool)"
+	}
+
+	labels := NodeLabelsTable(node)
+	labels.Title = "Labels"
+	labels.Columns = labels.Columns[1:3]
+	for i, row := range labels.Rows {
+		labels.Rows[i] = row[1:3]
+	}
+	labels.Names = nil
+	labels.Empty = len(node.Labels) == 0
+
+	placements := Table{
+		Title:   "Placements",
+		Kind:    "placements",
+		Columns: []Column{{Header: "Kind"}, {Header: "Config"}, {Header: "Task ID"}, {Header: "Status"}, {Header: "Accepted At", Wide: true}, {Header: "Resolved At", Wide: true}},
+		Empty:   len(description.Placements) == 0,
+	}
+	for _, placement := range description.Placements {
+		task := placement.Task
+		placements.Rows = append(placements.Rows, []string{placement.Kind, placement.Config, task.ID, task.Status, task.AcceptedAt, task.ResolvedAt})
+	}
+
+	metrics := NodeMetricsTable(description.Metrics, "Node")
+	metrics.Title = "Metrics"
+
+	tables := []Table{
+		{
+			Columns:  []Column{{Header: "Node ID"}, {Header: "Owner"}, {Header: "Labels"}},
+			Rows:     [][]string{{node.ID, owner, strconv.Itoa(len(node.Labels))}},
+			Names:    []string{nodeName(node)},
+			Vertical: true,
+		},
+		labels,
+		placements,
+		metrics,
+	}
+
+	if len(description.Warnings) > 0 {
+		warnings := Table{Title: "Warnings", Columns: []Column{{Header: "Warning"}}, Vertical: true}
+		for _, warning := range description.Warnings {
+			warnings.Rows = append(warnings.Rows, []string{warning})
 		}
+		tables = append(tables, warnings)
+	}
+	return tables
+}
+
+// PreviewNodes shows the nodes a claim or release is about to change, out of
+// the given number of matching nodes.
+func PreviewNodes(title string, nodes []model.Node, matching int) error {
+	preview := NodesTable(nodes)
+	preview.Title = title
+	preview.Footer = NodesFooter(nodes, matching)
+	return PrintPreview(preview)
+}
 
-		avgMhz := totalMhz / float64(coreCount)
-		avgCache := totalCache / float64(coreCount)
+// OwnershipSummaryTable lists the nodes a claim or release changed, with the
+// skipped and unselected nodes in the footer.
+func OwnershipSummaryTable(summary model.OwnershipSummary) Table {
+	table := NodesTable(summary.Nodes)
+	table.Empty = len(summary.Nodes) == 0 && len(summary.Skipped) == 0
+
+	action := strings.ToUpper(summary.Action[:1]) + summary.Action[1:]
+	preposition := "for"
+	if summary.Action == "released" {
+		preposition = "from"
+	}
+	table.Footer = fmt.Sprintf("%s %s %s %s", action, NodeCount(len(summary.Nodes)), preposition, summary.Org)
+	if len(summary.Skipped) > 0 {
+		table.Footer += fmt.Sprintf(", skipped %d: %s", len(summary.Skipped), strings.Join(summary.Skipped, ", "))
+	}
+	if len(summary.Unselected) > 0 {
+		table.Footer += fmt.Sprintf(", not selected %d: %s", len(summary.Unselected), strings.Join(summary.Unselected, ", "))
+	}
+	table.Footer += "."
+	return table
+}
+
+// NodeCount returns e.g. "1 node" or "3 nodes".
+func NodeCount(count int) string {
+	if count == 1 {
+		return "1 node"
+	}
+	return strconv.Itoa(count) + " nodes"
+}
+
+func nodeName(node model.Node) string {
+	return "node/" + node.ID
+}
+
+func formatFloat(value float64) string {
+	return fmt.Sprintf("%.2f", value)
+}
 
-		fmt.Fprintf(w, "%s\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\n", node.ID, cpuCores, avgMhz, avgCache, memoryTotalGB, diskTotalGB, diskFreeGB)
+// formatAverage shows <none> rather than NaN when there is nothing to average.
+func formatAverage(total float64, count int) string {
+	if count == 0 {
+		return "<none>"
 	}
+	return formatFloat(total / float64(count))
 }
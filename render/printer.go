@@ -0,0 +1,390 @@
+package render
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/c12s/cockpit/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats accepted by --output. jsonpath and go-template take their
+// expression after an equals sign, e.g. -o jsonpath='{.name}'.
+const (
+	OutputTable      = "table"
+	OutputWide       = "wide"
+	OutputJSON       = "json"
+	OutputYAML       = "yaml"
+	OutputJSONPath   = "jsonpath"
+	OutputGoTemplate = "go-template"
+	OutputCSV        = "csv"
+	OutputName       = "name"
+)
+
+var OutputFormats = []string{OutputTable, OutputWide, OutputJSON, OutputYAML, OutputJSONPath, OutputGoTemplate, OutputCSV, OutputName}
+
+// Table is the tabular form of a response, shared by the table, wide and csv
+// outputs. Wide columns are only shown by the wide and csv outputs.
+type Table struct {
+	// Kind names the listed resources in the message printed for empty results.
+	Kind     string
+	Columns  []Column
+	Rows     [][]string
+	Names    []string
+	Empty    bool
+	Vertical bool
+	// Title and Footer are printed above and below the table and wide outputs.
+	Title  string
+	Footer string
+}
+
+type Column struct {
+	Header string
+	Wide   bool
+}
+
+type printer struct {
+	format   string
+	jsonPath *jsonPath
+	template *template.Template
+}
+
+var output = printer{format: OutputTable}
+
+var stdout io.Writer = os.Stdout
+
+// SetOutput selects the format used by Print and PrintTables.
+func SetOutput(value string) error {
+	format, expression, _ := strings.Cut(value, "=")
+	next := printer{format: format}
+
+	switch format {
+	case "":
+		next.format = OutputTable
+	case OutputTable, OutputWide, OutputJSON, OutputYAML, OutputCSV, OutputName:
+		if expression != "" {
+			return fmt.Errorf("output format %q does not take an expression", format)
+		}
+	case OutputJSONPath:
+		path, err := parseJSONPath(expression)
+		if err != nil {
+			return fmt.Errorf("invalid jsonpath expression: %w", err)
+		}
+		next.jsonPath = path
+	case OutputGoTemplate:
+		if expression == "" {
+			return fmt.Errorf("go-template output requires a template, e.g. -o go-template='{{.name}}'")
+		}
+		tmpl, err := template.New("output").Parse(expression)
+		if err != nil {
+			return fmt.Errorf("invalid go-template: %w", err)
+		}
+		next.template = tmpl
+	default:
+		return fmt.Errorf("unknown output format %q, expected one of: %s", format, strings.Join(OutputFormats, ", "))
+	}
+
+	output = next
+	return nil
+}
+
+func OutputFormat() string {
+	return output.format
+}
+
+// TableOutput reports whether the table or wide output is selected.
+func TableOutput() bool {
+	return output.format == OutputTable || output.format == OutputWide
+}
+
+// Print writes data in the selected output format, and to the --save-to file
+// when one is set.
+func Print(data interface{}) error {
+	var tables []Table
+	if table, ok := tableOf(data); ok {
+		tables = []Table{table}
+	}
+	return PrintTables(data, tables...)
+}
+
+// PrintTables writes data like Print, using the given tables instead of the
+// default ones for the table, wide, csv and name outputs.
+func PrintTables(data interface{}, tables ...Table) error {
+	if err := output.print(data, tables); err != nil {
+		return err
+	}
+	return SaveResponse(data)
+}
+
+// PrintPreview writes a table to stderr, so that previews shown before a
+// confirmation prompt stay out of the selected output.
+func PrintPreview(table Table) error {
+	out := stdout
+	stdout = os.Stderr
+	defer func() { stdout = out }()
+	return printTable(table, output.format == OutputWide)
+}
+
+func (p printer) print(data interface{}, tables []Table) error {
+	data = normalize(data)
+
+	switch p.format {
+	case OutputJSON:
+		return printJSON(data)
+	case OutputYAML:
+		return printYAML(data)
+	case OutputJSONPath:
+		return p.printJSONPath(data)
+	case OutputGoTemplate:
+		return p.printTemplate(data)
+	}
+
+	if result, ok := data.(model.Result); ok {
+		return printResults([]model.Result{result})
+	}
+	if results, ok := data.([]model.Result); ok {
+		return printResults(results)
+	}
+
+	if tables == nil {
+		if p.format == OutputTable || p.format == OutputWide {
+			return printYAML(data)
+		}
+		return fmt.Errorf("output format %q is not supported for this response, use json or yaml", p.format)
+	}
+
+	if p.format == OutputName {
+		return printNames(tables)
+	}
+
+	for i, table := range tables {
+		var err error
+		switch p.format {
+		case OutputCSV:
+			err = printCSV(table)
+		default:
+			if i > 0 {
+				fmt.Fprintln(stdout)
+			}
+			err = printTable(table, p.format == OutputWide)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalize turns a nil slice into an empty one, so empty results print as
+// [] rather than null.
+func normalize(data interface{}) interface{} {
+	value := reflect.ValueOf(data)
+	if value.Kind() == reflect.Slice && value.IsNil() {
+		return reflect.MakeSlice(value.Type(), 0, 0).Interface()
+	}
+	return data
+}
+
+func printJSON(data interface{}) error {
+	jsonData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to convert response to JSON: %w", err)
+	}
+	fmt.Fprintln(stdout, string(jsonData))
+	return nil
+}
+
+func printYAML(data interface{}) error {
+	yamlData, err := yaml.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to convert response to YAML: %w", err)
+	}
+	fmt.Fprint(stdout, string(yamlData))
+	return nil
+}
+
+func (p printer) printJSONPath(data interface{}) error {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+	text, err := p.jsonPath.execute(generic)
+	if err != nil {
+		return err
+	}
+	printText(text)
+	return nil
+}
+
+func (p printer) printTemplate(data interface{}) error {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := p.template.Execute(&buf, generic); err != nil {
+		return fmt.Errorf("failed to execute go-template: %w", err)
+	}
+	printText(buf.String())
+	return nil
+}
+
+func printText(text string) {
+	if text != "" && !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	fmt.Fprint(stdout, text)
+}
+
+// toGeneric converts data to the maps and slices it encodes to in JSON, so
+// jsonpath expressions and templates address fields by their JSON names.
+func toGeneric(data interface{}) (interface{}, error) {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert response to JSON: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, fmt.Errorf("failed to convert response to JSON: %w", err)
+	}
+	return generic, nil
+}
+
+func printResults(results []model.Result) error {
+	if output.format == OutputCSV {
+		return printCSV(resultsTable(results))
+	}
+	for _, result := range results {
+		if output.format == OutputName {
+			fmt.Fprintln(stdout, resultName(result))
+			continue
+		}
+		fmt.Fprintf(stdout, "%s %s\n", resultName(result), result.Status)
+	}
+	return nil
+}
+
+func printTable(table Table, wide bool) error {
+	if table.Title != "" {
+		fmt.Fprintf(stdout, "%s:\n", table.Title)
+	}
+	if table.Empty {
+		fmt.Fprintf(os.Stderr, "No %s were found.\n", table.Kind)
+		return nil
+	}
+
+	columns := visibleColumns(table, wide)
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+
+	if table.Vertical {
+		for _, row := range table.Rows {
+			for _, i := range columns {
+				fmt.Fprintf(w, "%s:\t%s\n", table.Columns[i].Header, row[i])
+			}
+		}
+		return w.Flush()
+	}
+
+	headers := make([]string, len(columns))
+	for j, i := range columns {
+		headers[j] = table.Columns[i].Header
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, row := range table.Rows {
+		cells := make([]string, len(columns))
+		for j, i := range columns {
+			cells[j] = row[i]
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if table.Footer != "" {
+		fmt.Fprintf(stdout, "\n%s\n", table.Footer)
+	}
+	return nil
+}
+
+func visibleColumns(table Table, wide bool) []int {
+	var columns []int
+	for i, column := range table.Columns {
+		if wide || !column.Wide {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
+
+func printCSV(table Table) error {
+	w := csv.NewWriter(stdout)
+	headers := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		headers[i] = column.Header
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+	if err := w.WriteAll(table.Rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// printNames prints the names of all tables. Tables without names are
+// skipped as long as one of the tables lists names.
+func printNames(tables []Table) error {
+	supported := false
+	for _, table := range tables {
+		if table.Names != nil || table.Empty {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf("output format %q is not supported for this response", OutputName)
+	}
+
+	for _, table := range tables {
+		for _, name := range table.Names {
+			fmt.Fprintln(stdout, name)
+		}
+	}
+	return nil
+}
+
+func resultName(result model.Result) string {
+	return result.Kind + "/" + result.Name
+}
+
+// PreviewResults lists the objects a command is about to change on stderr,
+// without their status.
+func PreviewResults(title string, results []model.Result) error {
+	table := resultsTable(results)
+	table.Title = title
+	table.Columns = table.Columns[:2]
+	for i, row := range table.Rows {
+		table.Rows[i] = row[:2]
+	}
+	return PrintPreview(table)
+}
+
+func resultsTable(results []model.Result) Table {
+	table := Table{Columns: []Column{{Header: "Kind"}, {Header: "Name"}, {Header: "Status"}}}
+	for _, result := range results {
+		table.Rows = append(table.Rows, []string{result.Kind, result.Name, result.Status})
+		table.Names = append(table.Names, resultName(result))
+	}
+	return table
+}
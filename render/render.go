@@ -1,62 +1,48 @@
 package render
 
 import (
-	"encoding/json"
-	"fmt"
+	"github.com/c12s/cockpit/config"
 	"github.com/c12s/cockpit/model"
-	"gopkg.in/yaml.v3"
 )
 
-func RenderResponseAsTabWriter(data interface{}) {
+// tableOf returns the default table for a response. Responses without one
+// are printed as YAML by the table and wide outputs.
+func tableOf(data interface{}) (Table, bool) {
 	switch v := data.(type) {
 	case model.ConfigGroup:
-		RenderConfigGroupTabWriter(v)
+		return ConfigGroupsTable([]model.ConfigGroup{v}), true
+	case []model.ConfigGroup:
+		return ConfigGroupsTable(v), true
 	case model.StandaloneConfig:
-		RenderStandaloneConfigTabWriter(v)
+		return StandaloneConfigsTable([]model.StandaloneConfig{v}), true
+	case []model.StandaloneConfig:
+		return StandaloneConfigsTable(v), true
 	case model.ConfigGroupDiffResponse:
-		RenderConfigGroupDiffsTabWriter(v)
+		return ConfigGroupDiffsTable(v), true
 	case model.StandaloneConfigDiffResponse:
-		RenderStandaloneConfigDiffsTabWriter(v)
-	case model.SchemaData:
-		RenderSchemaTabWriter(v)
+		return StandaloneConfigDiffsTable(v), true
 	case []model.SchemaVersion:
-		RenderSchemaVersionsTabWriter(v)
-	case []model.StandaloneConfig:
-		RenderStandaloneConfigsTabWriter(v)
-	case []model.ConfigGroup:
-		RenderConfigGroupsTabWriter(v)
+		return SchemaVersionsTable(v), true
 	case []model.Task:
-		RenderTasksTabWriter(v)
+		return TasksTable(v), true
+	case model.Node:
+		return NodeLabelsTable(v), true
 	case []model.Node:
-		RenderNodesTabWriter(v)
-	default:
-		fmt.Println("Unsupported data type for tabular rendering")
-	}
-}
-
-func DisplayResponseAsJSONOrYAML(response interface{}, format, message string) {
-	switch format {
-	case "json":
-		jsonData, err := json.MarshalIndent(response, "", "  ")
-		if err != nil {
-			fmt.Printf("Error converting response to JSON: %v\n", err)
-			return
-		}
-		fmt.Println(string(jsonData))
-		if message != "" {
-			fmt.Print(message)
-		}
-	case "yaml":
-		yamlData, err := yaml.Marshal(response)
-		if err != nil {
-			fmt.Printf("Error converting response to YAML: %v\n", err)
-			return
-		}
-		fmt.Println(string(yamlData))
-		if message != "" {
-			fmt.Print(message)
-		}
-	default:
-		fmt.Printf("Invalid output format: %v. Supported formats are 'json' and 'yaml'\n", format)
+		return NodesTable(v), true
+	case model.OwnershipSummary:
+		return OwnershipSummaryTable(v), true
+	case model.NodeLabels:
+		return TypedLabelsTable(v), true
+	case []model.LabelChangeResult:
+		return LabelChangesTable(v), true
+	case model.PoliciesRequest:
+		return PoliciesTable(v), true
+	case model.Relation:
+		return RelationTable(v), true
+	case model.WhoAmI:
+		return WhoAmITable(v), true
+	case config.ClientConfig:
+		return ContextsTable(v), true
 	}
+	return Table{}, false
 }
@@ -0,0 +1,35 @@
+package render
+
+import (
+	"github.com/c12s/cockpit/model"
+)
+
+func PoliciesTable(policy model.PoliciesRequest) Table {
+	return Table{
+		Columns: []Column{
+			{Header: "Subject"},
+			{Header: "Object"},
+			{Header: "Permission"},
+			{Header: "Kind"},
+			{Header: "Condition", Wide: true},
+		},
+		Rows: [][]string{{
+			entityName(policy.SubjectScope.Kind, policy.SubjectScope.ID),
+			entityName(policy.ObjectScope.Kind, policy.ObjectScope.ID),
+			policy.Permission.Name,
+			policy.Permission.Kind,
+			policy.Permission.Condition.Expression,
+		}},
+	}
+}
+
+func RelationTable(relation model.Relation) Table {
+	return Table{
+		Columns: []Column{{Header: "From"}, {Header: "To"}},
+		Rows:    [][]string{{entityName(relation.From.Kind, relation.From.ID), entityName(relation.To.Kind, relation.To.ID)}},
+	}
+}
+
+func entityName(kind, id string) string {
+	return kind + "/" + id
+}
@@ -0,0 +1,81 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/c12s/cockpit/utils"
+	"gopkg.in/yaml.v3"
+)
+
+type saveTarget struct {
+	path   string
+	format string
+	force  bool
+}
+
+var saveTo saveTarget
+
+// SetSaveTo makes Print and PrintTables also write the response to path. The
+// file format follows the extension (.json, .yaml or .yml), or the output
+// format when it is json or yaml. It must be called after SetOutput.
+func SetSaveTo(path string, force bool) error {
+	if path == "" {
+		saveTo = saveTarget{}
+		return nil
+	}
+
+	format, err := saveFormat(path)
+	if err != nil {
+		return err
+	}
+	if err := utils.CheckOverwrite(path, force); err != nil {
+		return err
+	}
+
+	saveTo = saveTarget{path: path, format: format, force: force}
+	return nil
+}
+
+func saveFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return OutputJSON, nil
+	case ".yaml", ".yml":
+		return OutputYAML, nil
+	}
+	if output.format == OutputJSON || output.format == OutputYAML {
+		return output.format, nil
+	}
+	return "", fmt.Errorf("cannot tell the format of %s, use a .json, .yaml or .yml extension or -o json|yaml", path)
+}
+
+// SaveResponse writes data to the --save-to file, if one is set. Print and
+// PrintTables call it themselves.
+func SaveResponse(data interface{}) error {
+	if saveTo.path == "" {
+		return nil
+	}
+	data = normalize(data)
+
+	var content []byte
+	var err error
+	if saveTo.format == OutputJSON {
+		content, err = json.MarshalIndent(data, "", "  ")
+		content = append(content, '\n')
+	} else {
+		content, err = yaml.Marshal(data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode response for %s: %w", saveTo.path, err)
+	}
+
+	if err := utils.WriteFileAtomic(saveTo.path, content, saveTo.force); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Response saved to %s\n", saveTo.path)
+	return nil
+}
@@ -1,41 +1,21 @@
 package render
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	"github.com/c12s/cockpit/model"
 )
 
-func RenderSchemaVersionsTabWriter(versions []model.SchemaVersion) {
-	if len(versions) == 0 {
-		fmt.Println("No schema versions were found.")
-		return
+func SchemaVersionsTable(versions []model.SchemaVersion) Table {
+	table := Table{
+		Kind:    "schema versions",
+		Columns: []Column{{Header: "Organization"}, {Header: "Namespace"}, {Header: "Schema Name"}, {Header: "Version"}, {Header: "Creation Time"}},
+		Names:   []string{},
+		Empty:   len(versions) == 0,
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintln(w, "Organization\tNamespace\tSchema Name\tVersion\tCreation Time\t")
-
 	for _, version := range versions {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\t\n",
-			version.SchemaDetails.Organization,
-			version.SchemaDetails.Namespace,
-			version.SchemaDetails.SchemaName,
-			version.SchemaDetails.Version,
-			version.SchemaData.CreationTime)
+		details := version.SchemaDetails
+		table.Rows = append(table.Rows, []string{details.Organization, details.Namespace, details.SchemaName, details.Version, version.SchemaData.CreationTime})
+		table.Names = append(table.Names, "schema/"+configName(details.Organization, details.Namespace, details.SchemaName, details.Version))
 	}
-}
-
-func RenderSchemaTabWriter(schema model.SchemaData) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent)
-	defer w.Flush()
-
-	fmt.Fprintln(w, "Schema\tCreation Time\t")
-
-	fmt.Fprintf(w, "%s\t%s\t\t\n",
-		schema.Schema,
-		schema.CreationTime)
+	return table
 }
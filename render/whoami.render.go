@@ -0,0 +1,35 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func WhoAmITable(whoami model.WhoAmI) Table {
+	expires := "never"
+	if expiry, ok := whoami.Claims.Expiry(); ok {
+		expires = expiry.Format(time.RFC3339)
+		if time.Now().After(expiry) {
+			expires += " (expired)"
+		} else {
+			expires += fmt.Sprintf(" (in %s)", time.Until(expiry).Round(time.Second))
+		}
+	}
+
+	return Table{
+		Columns: []Column{
+			{Header: "Context"},
+			{Header: "Gateway"},
+			{Header: "User"},
+			{Header: "Subject"},
+			{Header: "Organization"},
+			{Header: "Issuer"},
+			{Header: "Expires"},
+		},
+		Rows:     [][]string{{whoami.Context, whoami.Gateway, whoami.User, whoami.Claims.Subject, whoami.Claims.Org, whoami.Claims.Issuer, expires}},
+		Names:    []string{"user/" + whoami.User},
+		Vertical: true,
+	}
+}
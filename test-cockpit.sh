@@ -40,7 +40,7 @@ run_command "list" "nodes" "--query 'memory-totalGB > 2'" \
             "List nodes with query command completed successfully"
 
 # Claim nodes
-run_command "claim" "nodes" "--org c12s --query 'memory-totalGB > 2'" \
+run_command "claim" "nodes" "--org c12s --query 'memory-totalGB > 2' --yes" \
             "Claim nodes command failed" \
             "Claim nodes command completed successfully"
 
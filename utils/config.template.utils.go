@@ -0,0 +1,324 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/c12s/cockpit/model"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigSources are the files and --set params a config document is built
+// from. Kind is model.KindConfigGroup or model.KindStandaloneConfig, or empty
+// to tell them apart by the paramSets or paramSet field of the base file.
+type ConfigSources struct {
+	Kind     string
+	Path     string
+	Overlays []string
+	Values   []string
+	Sets     []string
+}
+
+// BuildConfigDocument reads the config file at Path, merges the overlay files
+// into it in order and applies the --set params. Param sets are merged by
+// name and params by key; other fields of an overlay replace those of the
+// base. With values files, the config files are rendered as Go templates
+// with the merged values first. Without overlays, values or --set params,
+// the file is returned as it is parsed.
+func BuildConfigDocument(sources ConfigSources) (map[string]interface{}, error) {
+	if len(sources.Overlays) == 0 && len(sources.Values) == 0 && len(sources.Sets) == 0 {
+		return PrepareRequestBodyFromYAMLOrJSON(sources.Path)
+	}
+
+	values, err := readTemplateValues(sources.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := readConfigTemplate(sources.Path, values)
+	if err != nil {
+		return nil, err
+	}
+	kind := sources.Kind
+	if kind == "" {
+		kind = model.KindConfigGroup
+		if _, ok := document["paramSet"]; ok {
+			kind = model.KindStandaloneConfig
+		}
+	}
+	if err := mergeConfigDocument(kind, document, map[string]interface{}{}, sources.Path); err != nil {
+		return nil, err
+	}
+
+	for _, path := range sources.Overlays {
+		overlay, err := readConfigTemplate(path, values)
+		if err != nil {
+			return nil, err
+		}
+		if err := mergeConfigDocument(kind, document, overlay, path); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, set := range sources.Sets {
+		if err := applyConfigSet(kind, document, set); err != nil {
+			return nil, err
+		}
+	}
+	return document, nil
+}
+
+func readTemplateValues(paths []string) (map[string]interface{}, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	values := map[string]interface{}{}
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values file: %v", err)
+		}
+		var fileValues map[string]interface{}
+		if err := yaml.Unmarshal(content, &fileValues); err != nil {
+			return nil, &ValidationError{Message: fmt.Sprintf("invalid values file %s: %v", path, err)}
+		}
+		mergeValues(values, fileValues)
+	}
+	return values, nil
+}
+
+// mergeValues merges values files key by key, so that a later file can
+// override a single nested value.
+func mergeValues(values, next map[string]interface{}) {
+	for key, value := range next {
+		nested, ok := value.(map[string]interface{})
+		existing, existingOk := values[key].(map[string]interface{})
+		if ok && existingOk {
+			mergeValues(existing, nested)
+			continue
+		}
+		values[key] = value
+	}
+}
+
+func readConfigTemplate(path string, values map[string]interface{}) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	if values != nil {
+		tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(content))
+		if err != nil {
+			return nil, &ValidationError{Message: fmt.Sprintf("invalid template %s: %v", path, err)}
+		}
+		var rendered bytes.Buffer
+		if err := tmpl.Execute(&rendered, values); err != nil {
+			return nil, &ValidationError{Message: fmt.Sprintf("failed to render %s: %v", path, err)}
+		}
+		content = rendered.Bytes()
+	}
+
+	document, err := parseYAMLOrJSON(path, content)
+	if err != nil {
+		return nil, &ValidationError{Message: fmt.Sprintf("%s: %v", path, err)}
+	}
+	if document == nil {
+		document = map[string]interface{}{}
+	}
+	return document, nil
+}
+
+func mergeConfigDocument(kind string, document, overlay map[string]interface{}, path string) error {
+	field := configParamsField(kind)
+	for key, value := range overlay {
+		if key != field {
+			document[key] = value
+		}
+	}
+
+	invalid := func(err error) error {
+		return &ValidationError{Message: fmt.Sprintf("invalid %s in %s: %v", field, path, err)}
+	}
+	if kind == model.KindStandaloneConfig {
+		params, err := decodeParams(document[field])
+		if err != nil {
+			return invalid(err)
+		}
+		overlayParams, err := decodeParams(overlay[field])
+		if err != nil {
+			return invalid(err)
+		}
+		document[field] = mergeParams(params, overlayParams)
+		return nil
+	}
+
+	paramSets, err := decodeParamSets(document[field])
+	if err != nil {
+		return invalid(err)
+	}
+	overlayParamSets, err := decodeParamSets(overlay[field])
+	if err != nil {
+		return invalid(err)
+	}
+	for _, overlaySet := range overlayParamSets {
+		paramSets = mergeParamSet(paramSets, overlaySet.(map[string]interface{}))
+	}
+	document[field] = paramSets
+	return nil
+}
+
+// applyConfigSet sets a param given as paramSet.key=value, or key=value for
+// standalone configs.
+func applyConfigSet(kind string, document map[string]interface{}, set string) error {
+	field := configParamsField(kind)
+	name, value, ok := strings.Cut(set, "=")
+	if kind == model.KindStandaloneConfig {
+		if !ok || name == "" {
+			return &ValidationError{Message: fmt.Sprintf("invalid --set %q, expected key=value", set)}
+		}
+		document[field] = mergeParams(document[field].([]interface{}), []interface{}{newParam(name, value)})
+		return nil
+	}
+
+	paramSet, key, hasKey := strings.Cut(name, ".")
+	if !ok || !hasKey || paramSet == "" || key == "" {
+		return &ValidationError{Message: fmt.Sprintf("invalid --set %q, expected paramSet.key=value", set)}
+	}
+	next := map[string]interface{}{"name": paramSet, "paramSet": []interface{}{newParam(key, value)}}
+	document[field] = mergeParamSet(document[field].([]interface{}), next)
+	return nil
+}
+
+func configParamsField(kind string) string {
+	if kind == model.KindStandaloneConfig {
+		return "paramSet"
+	}
+	return "paramSets"
+}
+
+func newParam(key, value string) map[string]interface{} {
+	return map[string]interface{}{"key": key, "value": value}
+}
+
+// mergeParamSet merges next into the param set with the same name, replacing
+// its other fields, or appends it.
+func mergeParamSet(paramSets []interface{}, next map[string]interface{}) []interface{} {
+	for _, item := range paramSets {
+		paramSet := item.(map[string]interface{})
+		if paramSet["name"] != next["name"] {
+			continue
+		}
+		for field, value := range next {
+			if field != "paramSet" {
+				paramSet[field] = value
+			}
+		}
+		paramSet["paramSet"] = mergeParams(paramSet["paramSet"].([]interface{}), next["paramSet"].([]interface{}))
+		return paramSets
+	}
+	return append(paramSets, next)
+}
+
+// mergeParams replaces the fields of params with the same keys as next ones
+// in place, and appends the others.
+func mergeParams(params, next []interface{}) []interface{} {
+	merged := append([]interface{}{}, params...)
+	for _, item := range next {
+		param := item.(map[string]interface{})
+		replaced := false
+		for _, existing := range merged {
+			existingParam := existing.(map[string]interface{})
+			if existingParam["key"] == param["key"] {
+				for field, value := range param {
+					existingParam[field] = value
+				}
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, param)
+		}
+	}
+	return merged
+}
+
+// decodeParamSets checks a list of param sets, keeping fields other than name
+// and paramSet as they are.
+func decodeParamSets(value interface{}) ([]interface{}, error) {
+	items, err := decodeList(value, "param sets")
+	if err != nil {
+		return nil, err
+	}
+
+	paramSets := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a param set with name and paramSet")
+		}
+		params, err := decodeParams(fields["paramSet"])
+		if err != nil {
+			return nil, err
+		}
+		fields["name"] = scalarString(fields["name"])
+		fields["paramSet"] = params
+		paramSets = append(paramSets, fields)
+	}
+	return paramSets, nil
+}
+
+// decodeParams checks a list of params, taking keys and values written
+// without quotes as their text and keeping other fields as they are.
+func decodeParams(value interface{}) ([]interface{}, error) {
+	items, err := decodeList(value, "params")
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a param with key and value")
+		}
+		fields["key"] = scalarString(fields["key"])
+		fields["value"] = scalarString(fields["value"])
+		params = append(params, fields)
+	}
+	return params, nil
+}
+
+func decodeList(value interface{}, what string) ([]interface{}, error) {
+	if value == nil {
+		return []interface{}{}, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a list of %s", what)
+	}
+	return items, nil
+}
+
+// scalarString writes numbers in full, so that 1500000.0 is "1500000"
+// rather than "1.5e+06".
+func scalarString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	}
+	return fmt.Sprint(value)
+}
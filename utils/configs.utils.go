@@ -1,13 +1,15 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/c12s/cockpit/model"
 )
 
-func PrepareConfigDiffRequest(namespace, names, versions, organization string) (interface{}, error) {
+func PrepareConfigDiffRequest(namespace, names, versions, organization string) (model.SingleConfigDiffRequest, error) {
 	namesList := strings.Split(names, "|")
 	versionsList := strings.Split(versions, "|")
 
@@ -20,7 +22,7 @@ func PrepareConfigDiffRequest(namespace, names, versions, organization string) (
 	}
 
 	if len(namesList) != 2 || len(versionsList) != 2 {
-		return nil, fmt.Errorf("invalid names or versions format. Please use 'name1|name2' and 'version1|version2'")
+		return model.SingleConfigDiffRequest{}, fmt.Errorf("invalid names or versions format. Please use 'name1|name2' and 'version1|version2'")
 	}
 
 	requestBody := model.SingleConfigDiffRequest{
@@ -40,3 +42,303 @@ func PrepareConfigDiffRequest(namespace, names, versions, organization string) (
 
 	return requestBody, nil
 }
+
+// PrepareConfigReferenceDiffRequest builds a diff request from two config
+// references, which may be in different organizations and namespaces. See
+// ParseConfigReference for their format.
+func PrepareConfigReferenceDiffRequest(from, to, organization, namespace string) (model.SingleConfigDiffRequest, error) {
+	defaults := model.ConfigReference{Organization: organization, Namespace: namespace}
+	fromReference, err := ParseConfigReference(from, defaults)
+	if err != nil {
+		return model.SingleConfigDiffRequest{}, err
+	}
+	toReference, err := ParseConfigReference(to, defaults)
+	if err != nil {
+		return model.SingleConfigDiffRequest{}, err
+	}
+	return model.SingleConfigDiffRequest{
+		Reference: ToSingleConfigReference(fromReference),
+		Diff:      ToSingleConfigReference(toReference),
+	}, nil
+}
+
+// ParseConfigReference parses a config reference of the form
+// [[org/]namespace/]name@version. The organization and namespace left out
+// are taken from defaults.
+func ParseConfigReference(reference string, defaults model.ConfigReference) (model.ConfigReference, error) {
+	invalid := &ValidationError{Message: fmt.Sprintf("invalid config reference %q, expected [[org/]namespace/]name@version", reference)}
+
+	path, version, ok := strings.Cut(reference, "@")
+	if !ok || version == "" || strings.Contains(version, "/") {
+		return model.ConfigReference{}, invalid
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) > 3 {
+		return model.ConfigReference{}, invalid
+	}
+	for _, part := range parts {
+		if part == "" {
+			return model.ConfigReference{}, invalid
+		}
+	}
+
+	result := model.ConfigReference{
+		Organization: defaults.Organization,
+		Namespace:    defaults.Namespace,
+		Name:         parts[len(parts)-1],
+		Version:      version,
+	}
+	if len(parts) > 1 {
+		result.Namespace = parts[len(parts)-2]
+	}
+	if len(parts) > 2 {
+		result.Organization = parts[0]
+	}
+	if result.Organization == "" || result.Namespace == "" {
+		return model.ConfigReference{}, &ValidationError{Message: fmt.Sprintf("config reference %q sets no organization or namespace, use org/namespace/name@version or --org and --namespace", reference)}
+	}
+	return result, nil
+}
+
+// ConfigReferenceName formats a config reference as org/namespace/name@version.
+func ConfigReferenceName(organization, namespace, name, version string) string {
+	return fmt.Sprintf("%s/%s/%s@%s", organization, namespace, name, version)
+}
+
+// ToSingleConfigReference converts a config group reference to the reference
+// type standalone config requests use.
+func ToSingleConfigReference(reference model.ConfigReference) model.SingleConfigReference {
+	return model.SingleConfigReference{
+		Namespace:    reference.Namespace,
+		Name:         reference.Name,
+		Organization: reference.Organization,
+		Version:      reference.Version,
+	}
+}
+
+// ReadConfigGroup reads a config group from a YAML or JSON file.
+func ReadConfigGroup(path string) (model.ConfigGroup, error) {
+	var group model.ConfigGroup
+	err := readConfigFile(path, &group)
+	return group, err
+}
+
+// ReadStandaloneConfig reads a standalone config from a YAML or JSON file.
+func ReadStandaloneConfig(path string) (model.StandaloneConfig, error) {
+	var config model.StandaloneConfig
+	err := readConfigFile(path, &config)
+	return config, err
+}
+
+// readConfigFile fails with a ValidationError, keeping exit code 1 for the
+// differences found by local diffs.
+func readConfigFile(path string, out interface{}) error {
+	data, err := PrepareRequestBodyFromYAMLOrJSON(path)
+	if err != nil {
+		return &ValidationError{Message: fmt.Sprintf("%s: %v", path, err)}
+	}
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(content, out); err != nil {
+		return &ValidationError{Message: fmt.Sprintf("invalid config file %s: %v", path, err)}
+	}
+	return nil
+}
+
+// DiffConfigGroups compares the param sets of two config groups in the shape
+// of the gateway's DiffConfigGroup response, going from old to new. Param
+// sets present on one side only have all of their params added or deleted.
+func DiffConfigGroups(old, new model.ConfigGroup) model.ConfigGroupDiffResponse {
+	oldSets := paramSetsByName(old.ParamSets)
+	newSets := paramSetsByName(new.ParamSets)
+
+	names := make([]string, 0, len(oldSets)+len(newSets))
+	for name := range oldSets {
+		names = append(names, name)
+	}
+	for name := range newSets {
+		if _, ok := oldSets[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	response := model.ConfigGroupDiffResponse{Diffs: map[string]model.ParamSetDiff{}}
+	for _, name := range names {
+		if diffs := diffParams(oldSets[name], newSets[name]); len(diffs) > 0 {
+			response.Diffs[name] = model.ParamSetDiff{Diffs: diffs}
+		}
+	}
+	return response
+}
+
+// DiffStandaloneConfigs compares the params of two standalone configs in the
+// shape of the gateway's DiffStandaloneConfig response, going from old to new.
+func DiffStandaloneConfigs(old, new model.StandaloneConfig) model.StandaloneConfigDiffResponse {
+	response := model.StandaloneConfigDiffResponse{Diffs: []model.SingleConfigDiff{}}
+	for _, diff := range diffParams(old.ParamSet, new.ParamSet) {
+		detail := map[string]string{"key": diff.Diff.Key}
+		if diff.Type == "replacement" {
+			detail["old_value"] = diff.Diff.OldValue
+			detail["new_value"] = diff.Diff.NewValue
+		} else {
+			detail["value"] = diff.Diff.Value
+		}
+		response.Diffs = append(response.Diffs, model.SingleConfigDiff{Type: diff.Type, Diff: detail})
+	}
+	return response
+}
+
+func paramSetsByName(paramSets []model.ParamSet) map[string][]model.Param {
+	byName := make(map[string][]model.Param, len(paramSets))
+	for _, paramSet := range paramSets {
+		byName[paramSet.Name] = paramSet.ParamSet
+	}
+	return byName
+}
+
+func diffParams(old, new []model.Param) []model.ConfigGroupDiff {
+	oldValues := make(map[string]string, len(old))
+	for _, param := range old {
+		oldValues[param.Key] = param.Value
+	}
+	newValues := make(map[string]string, len(new))
+	for _, param := range new {
+		newValues[param.Key] = param.Value
+	}
+
+	keys := make([]string, 0, len(oldValues)+len(newValues))
+	for key := range oldValues {
+		keys = append(keys, key)
+	}
+	for key := range newValues {
+		if _, ok := oldValues[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var diffs []model.ConfigGroupDiff
+	for _, key := range keys {
+		oldValue, inOld := oldValues[key]
+		newValue, inNew := newValues[key]
+		switch {
+		case !inNew:
+			diffs = append(diffs, model.ConfigGroupDiff{Type: "deletion", Diff: model.ConfigGroupDiffDetail{Key: key, Value: oldValue}})
+		case !inOld:
+			diffs = append(diffs, model.ConfigGroupDiff{Type: "addition", Diff: model.ConfigGroupDiffDetail{Key: key, Value: newValue}})
+		case oldValue != newValue:
+			diffs = append(diffs, model.ConfigGroupDiff{Type: "replacement", Diff: model.ConfigGroupDiffDetail{Key: key, OldValue: oldValue, NewValue: newValue}})
+		}
+	}
+	return diffs
+}
+
+// ConfigGroupHistory lists the versions of the named config group found in
+// groups from oldest to newest, each with the params changed since the
+// version before it. The first version lists no changes.
+func ConfigGroupHistory(groups []model.ConfigGroup, name string) []model.ConfigVersionHistory {
+	var versions []model.ConfigGroup
+	for _, group := range groups {
+		if group.Name == name {
+			versions = append(versions, group)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versionBefore(versions[i].Version, versions[i].CreatedAt, versions[j].Version, versions[j].CreatedAt)
+	})
+
+	history := make([]model.ConfigVersionHistory, 0, len(versions))
+	var previous model.ConfigGroup
+	for i, group := range versions {
+		entry := model.ConfigVersionHistory{
+			Organization: group.Organization,
+			Namespace:    group.Namespace,
+			Name:         group.Name,
+			Version:      group.Version,
+			CreatedAt:    group.CreatedAt,
+			Added:        []string{},
+			Deleted:      []string{},
+			Changed:      []string{},
+		}
+		for _, paramSet := range group.ParamSets {
+			entry.Params += len(paramSet.ParamSet)
+		}
+		if i > 0 {
+			diff := DiffConfigGroups(previous, group)
+			for paramSet, changes := range diff.Diffs {
+				addHistoryChanges(&entry, paramSet+".", changes.Diffs)
+			}
+			sortHistoryChanges(&entry)
+		}
+		history = append(history, entry)
+		previous = group
+	}
+	return history
+}
+
+// StandaloneConfigHistory lists the versions of the named standalone config
+// like ConfigGroupHistory.
+func StandaloneConfigHistory(configs []model.StandaloneConfig, name string) []model.ConfigVersionHistory {
+	var versions []model.StandaloneConfig
+	for _, config := range configs {
+		if config.Name == name {
+			versions = append(versions, config)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versionBefore(versions[i].Version, versions[i].CreatedAt, versions[j].Version, versions[j].CreatedAt)
+	})
+
+	history := make([]model.ConfigVersionHistory, 0, len(versions))
+	var previous []model.Param
+	for i, config := range versions {
+		entry := model.ConfigVersionHistory{
+			Organization: config.Organization,
+			Namespace:    config.Namespace,
+			Name:         config.Name,
+			Version:      config.Version,
+			CreatedAt:    config.CreatedAt,
+			Params:       len(config.ParamSet),
+			Added:        []string{},
+			Deleted:      []string{},
+			Changed:      []string{},
+		}
+		if i > 0 {
+			addHistoryChanges(&entry, "", diffParams(previous, config.ParamSet))
+			sortHistoryChanges(&entry)
+		}
+		history = append(history, entry)
+		previous = config.ParamSet
+	}
+	return history
+}
+
+func versionBefore(version, createdAt, otherVersion, otherCreatedAt string) bool {
+	if order := CompareVersions(version, otherVersion); order != 0 {
+		return order < 0
+	}
+	return createdAt < otherCreatedAt
+}
+
+func addHistoryChanges(entry *model.ConfigVersionHistory, prefix string, diffs []model.ConfigGroupDiff) {
+	for _, diff := range diffs {
+		key := prefix + diff.Diff.Key
+		switch diff.Type {
+		case "addition":
+			entry.Added = append(entry.Added, key)
+		case "deletion":
+			entry.Deleted = append(entry.Deleted, key)
+		case "replacement":
+			entry.Changed = append(entry.Changed, key)
+		}
+	}
+}
+
+func sortHistoryChanges(entry *model.ConfigVersionHistory) {
+	sort.Strings(entry.Added)
+	sort.Strings(entry.Deleted)
+	sort.Strings(entry.Changed)
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/constants"
+)
+
+// ErrNotFound is returned when a resource looked up client-side does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrExists is returned when a resource checked client-side already exists.
+var ErrExists = errors.New("already exists")
+
+// ValidationError reports an invalid flag or argument value.
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// ManifestError reports an invalid manifest file.
+type ManifestError struct {
+	Path    string
+	Message string
+}
+
+func (e *ManifestError) Error() string {
+	return fmt.Sprintf("invalid manifest %s: %s", e.Path, e.Message)
+}
+
+var exitCodes = map[client.Category]int{
+	client.CategoryAuth:       constants.ExitCodeAuth,
+	client.CategoryNotFound:   constants.ExitCodeNotFound,
+	client.CategoryConflict:   constants.ExitCodeConflict,
+	client.CategoryValidation: constants.ExitCodeValidation,
+	client.CategoryNetwork:    constants.ExitCodeNetwork,
+	client.CategoryTimeout:    constants.ExitCodeTimeout,
+}
+
+// ExitIfDryRun ends the process successfully when err only reports that a
+// dry run printed the request instead of sending it.
+func ExitIfDryRun(err error) {
+	if errors.Is(err, client.ErrDryRun) {
+		os.Exit(constants.ExitCodeOK)
+	}
+}
+
+// ExitCode maps err to the documented process exit code of its category.
+func ExitCode(err error) int {
+	if err == nil || errors.Is(err, client.ErrDryRun) {
+		return constants.ExitCodeOK
+	}
+	if errors.Is(err, ErrNotFound) {
+		return constants.ExitCodeNotFound
+	}
+	if errors.Is(err, ErrExists) || errors.Is(err, ErrFileExists) {
+		return constants.ExitCodeConflict
+	}
+	var queryErr *QueryError
+	if errors.As(err, &queryErr) {
+		return constants.ExitCodeValidation
+	}
+	var manifestErr *ManifestError
+	if errors.As(err, &manifestErr) {
+		return constants.ExitCodeValidation
+	}
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return constants.ExitCodeValidation
+	}
+	if code, ok := exitCodes[client.CategoryOf(err)]; ok {
+		return code
+	}
+	return constants.ExitCodeError
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/c12s/cockpit/client"
+	"github.com/c12s/cockpit/constants"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"success", nil, constants.ExitCodeOK},
+		{"dry run", fmt.Errorf("put: %w", client.ErrDryRun), constants.ExitCodeOK},
+		{"unknown error", errors.New("boom"), constants.ExitCodeError},
+		{"server error", &client.APIError{StatusCode: http.StatusInternalServerError}, constants.ExitCodeError},
+		{"unauthorized", &client.APIError{StatusCode: http.StatusUnauthorized}, constants.ExitCodeAuth},
+		{"no token", client.ErrUnauthenticated, constants.ExitCodeAuth},
+		{"gateway not found", &client.APIError{StatusCode: http.StatusNotFound}, constants.ExitCodeNotFound},
+		{"client-side not found", fmt.Errorf("node n1: %w", ErrNotFound), constants.ExitCodeNotFound},
+		{"gateway conflict", &client.APIError{StatusCode: http.StatusConflict}, constants.ExitCodeConflict},
+		{"client-side exists", fmt.Errorf("config v1: %w", ErrExists), constants.ExitCodeConflict},
+		{"file exists", fmt.Errorf("save: %w", ErrFileExists), constants.ExitCodeConflict},
+		{"gateway validation", &client.APIError{StatusCode: http.StatusBadRequest}, constants.ExitCodeValidation},
+		{"validation error", &ValidationError{Message: "invalid --bump"}, constants.ExitCodeValidation},
+		{"query error", &QueryError{Message: "unexpected token"}, constants.ExitCodeValidation},
+		{"manifest error", &ManifestError{Path: "m.yaml", Message: "missing kind"}, constants.ExitCodeValidation},
+		{"unreachable gateway", &client.TransportError{Err: errors.New("connection refused")}, constants.ExitCodeNetwork},
+		{"bad gateway", &client.APIError{StatusCode: http.StatusBadGateway}, constants.ExitCodeNetwork},
+		{"request timeout", &client.TransportError{Err: context.DeadlineExceeded}, constants.ExitCodeTimeout},
+		{"gateway timeout", &client.APIError{StatusCode: http.StatusGatewayTimeout}, constants.ExitCodeTimeout},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ExitCode(test.err); got != test.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// LabelSelector is a parsed kubectl-style label selector.
+type LabelSelector struct {
+	requirements []nodeExpr
+}
+
+var setRequirement = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// ParseLabelSelector parses comma-separated requirements: key=value (or ==),
+// key!=value, key in (a, b), key notin (a, b), key to require a label and
+// !key to require its absence. Values are compared as text.
+func ParseLabelSelector(selector string) (*LabelSelector, error) {
+	parsed := &LabelSelector{}
+	for _, part := range splitSelector(selector) {
+		text := strings.TrimSpace(part.text)
+		column := part.column + strings.Index(part.text, text)
+		if text == "" {
+			if strings.TrimSpace(selector) == "" {
+				break
+			}
+			return nil, selectorError(selector, column, "empty requirement")
+		}
+
+		requirement, err := parseRequirement(text)
+		if err != nil {
+			return nil, selectorError(selector, column, err.Error())
+		}
+		parsed.requirements = append(parsed.requirements, requirement)
+	}
+	return parsed, nil
+}
+
+// Filter returns the nodes matching every requirement of the selector.
+func (s *LabelSelector) Filter(nodes []model.Node) []model.Node {
+	if len(s.requirements) == 0 {
+		return nodes
+	}
+	matching := []model.Node{}
+	for _, node := range nodes {
+		if andExpr(s.requirements).match(nodeLabels(node)) {
+			matching = append(matching, node)
+		}
+	}
+	return matching
+}
+
+func selectorError(selector string, column int, message string) error {
+	return &QueryError{Query: selector, Column: column, Message: message, Subject: "label selector"}
+}
+
+type selectorPart struct {
+	text   string
+	column int
+}
+
+// splitSelector splits at the commas outside of parentheses, keeping the
+// 1-based column each part starts at.
+func splitSelector(selector string) []selectorPart {
+	var parts []selectorPart
+	runes := []rune(selector)
+	depth, start := 0, 0
+	for i, r := range runes {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selectorPart{text: string(runes[start:i]), column: start + 1})
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selectorPart{text: string(runes[start:]), column: start + 1})
+}
+
+func parseRequirement(text string) (nodeExpr, error) {
+	if match := setRequirement.FindStringSubmatch(text); match != nil {
+		var values []queryValue
+		for _, value := range strings.Split(match[3], ",") {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, fmt.Errorf("empty value in %q", text)
+			}
+			values = append(values, queryValue{text: value, quoted: true})
+		}
+		operator := "in"
+		if match[2] == "notin" {
+			operator = "not in"
+		}
+		return &predicate{key: match[1], operator: operator, values: values}, nil
+	}
+
+	for _, operator := range []string{"!=", "==", "="} {
+		key, value, found := strings.Cut(text, operator)
+		if !found {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if err := validateSelectorKey(key); err != nil {
+			return nil, err
+		}
+		return &predicate{key: key, operator: operator, values: []queryValue{{text: value, quoted: true}}}, nil
+	}
+
+	if key := strings.TrimPrefix(text, "!"); key != text {
+		key = strings.TrimSpace(key)
+		if err := validateSelectorKey(key); err != nil {
+			return nil, err
+		}
+		return notExpr{expr: &predicate{key: key, operator: "exists"}}, nil
+	}
+
+	if err := validateSelectorKey(text); err != nil {
+		return nil, err
+	}
+	return &predicate{key: text, operator: "exists"}, nil
+}
+
+func validateSelectorKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("missing label key")
+	}
+	if strings.ContainsAny(key, " \t()!=<>") {
+		return fmt.Errorf("invalid label key %q", key)
+	}
+	return nil
+}
+
+// NodeLabel returns the value of a node label as text.
+func NodeLabel(node model.Node, key string) (string, bool) {
+	for _, label := range node.Labels {
+		if label.Key == key {
+			return labelText(label.Value), true
+		}
+	}
+	return "", false
+}
+
+// SortNodes sorts nodes by the value of a label, numerically when both values
+// are numbers and as text otherwise. A leading '-' sorts in descending order.
+// Nodes without the label come last.
+func SortNodes(nodes []model.Node, key string) {
+	descending := strings.HasPrefix(key, "-")
+	key = strings.TrimPrefix(key, "-")
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		left, leftOk := NodeLabel(nodes[i], key)
+		right, rightOk := NodeLabel(nodes[j], key)
+		if !leftOk || !rightOk {
+			return leftOk && !rightOk
+		}
+
+		cmp := strings.Compare(left, right)
+		leftNumber, leftErr := strconv.ParseFloat(left, 64)
+		rightNumber, rightErr := strconv.ParseFloat(right, 64)
+		if leftErr == nil && rightErr == nil {
+			switch {
+			case leftNumber < rightNumber:
+				cmp = -1
+			case leftNumber > rightNumber:
+				cmp = 1
+			default:
+				cmp = 0
+			}
+		}
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestParseLabelSelectorMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     []string
+	}{
+		{"empty selector", "", []string{"n1", "n2", "n3", "n4"}},
+		{"blank selector", "  ", []string{"n1", "n2", "n3", "n4"}},
+		{"equals", "region=eu", []string{"n1", "n4"}},
+		{"double equals", "region==eu", []string{"n1", "n4"}},
+		{"spaces around operator", "region = eu", []string{"n1", "n4"}},
+		{"not equals skips nodes without the label", "env!=prod", []string{"n2", "n4"}},
+		{"numbers compared as text", "cpu-cores=4", []string{"n1"}},
+		{"numbers are not normalized", "cpu-cores=4.0", []string{}},
+		{"booleans compared as text", "gpu=true", []string{"n3"}},
+		{"in", "region in (eu, us)", []string{"n1", "n2", "n4"}},
+		{"in without space", "region in(asia)", []string{"n3"}},
+		{"notin", "env notin (prod, dev)", []string{"n4"}},
+		{"exists", "gpu", []string{"n3"}},
+		{"does not exist", "!gpu", []string{"n1", "n2", "n4"}},
+		{"does not exist with space", "! env", []string{"n3"}},
+		{"requirements are and-combined", "region=eu,env!=staging", []string{"n1"}},
+		{"commas inside parentheses", "region in (eu,asia),!env", []string{"n3"}},
+		{"trailing spaces", " region=eu , env=prod ", []string{"n1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := ParseLabelSelector(test.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector(%q): %v", test.selector, err)
+			}
+			if got := nodeIDs(selector.Filter(testNodes())); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Filter(%q) = %v, want %v", test.selector, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseLabelSelectorErrors(t *testing.T) {
+	tests := []struct {
+		selector string
+		column   int
+		message  string
+	}{
+		{"region=eu,,env=prod", 11, "empty requirement"},
+		{"region=eu,", 11, "empty requirement"},
+		{"region=eu, =x", 12, "missing label key"},
+		{"!", 1, "missing label key"},
+		{"a b=c", 1, `invalid label key "a b"`},
+		{"region=eu,(gpu)", 11, `invalid label key "(gpu)"`},
+		{"env in (prod,)", 1, `empty value in "env in (prod,)"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.selector, func(t *testing.T) {
+			_, err := ParseLabelSelector(test.selector)
+			var queryErr *QueryError
+			if !errors.As(err, &queryErr) {
+				t.Fatalf("ParseLabelSelector(%q) error = %v, want a QueryError", test.selector, err)
+			}
+			if queryErr.Subject != "label selector" || queryErr.Column != test.column || queryErr.Message != test.message {
+				t.Errorf("ParseLabelSelector(%q) = %s column %d %q, want label selector column %d %q",
+					test.selector, queryErr.Subject, queryErr.Column, queryErr.Message, test.column, test.message)
+			}
+		})
+	}
+}
+
+// TestSelectorWithQuery combines --selector with --query the way list nodes
+// does: the gateway evaluates the server part, the rest of the query and the
+// selector are applied locally.
+func TestSelectorWithQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		selector string
+		want     []string
+	}{
+		{"server query", "cpu-cores > 2", "region=eu", []string{"n1", "n4"}},
+		{"local query", "cpu-cores >= 4 or gpu", "region=eu", []string{"n1", "n4"}},
+		{"mixed query", "cpu-cores > 2 and not env = prod", "!gpu", []string{"n4"}},
+		{"selector excludes everything", "region = eu", "gpu", []string{}},
+		{"empty query", "", "env in (prod, dev)", []string{"n1", "n2"}},
+		{"empty selector", "gpu or env = dev", "", []string{"n2", "n3"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := ParseNodeQuery(test.query)
+			if err != nil {
+				t.Fatalf("ParseNodeQuery(%q): %v", test.query, err)
+			}
+			selector, err := ParseLabelSelector(test.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector(%q): %v", test.selector, err)
+			}
+
+			fromGateway := testNodes()
+			for _, query := range filter.Server {
+				fromGateway = filterNodes(fromGateway, &predicate{
+					key:      query.LabelKey,
+					operator: query.ShouldBe,
+					values:   []queryValue{{text: fmt.Sprint(query.Value)}},
+				})
+			}
+			if got := nodeIDs(selector.Filter(filter.Filter(fromGateway))); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("query %q with selector %q = %v, want %v", test.query, test.selector, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortNodes(t *testing.T) {
+	tests := []struct {
+		key  string
+		want []string
+	}{
+		{"cpu-cores", []string{"n2", "n1", "n3", "n4"}},
+		{"-cpu-cores", []string{"n4", "n3", "n1", "n2"}},
+		{"region", []string{"n3", "n1", "n4", "n2"}},
+		{"env", []string{"n2", "n1", "n4", "n3"}},
+		{"-env", []string{"n4", "n1", "n2", "n3"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			nodes := testNodes()
+			SortNodes(nodes, test.key)
+			if got := nodeIDs(nodes); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SortNodes(%q) = %v, want %v", test.key, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/c12s/cockpit/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Label value types, each put through its own gateway action. float64 is
+// accepted as another name for float.
+const (
+	LabelTypeString = "string"
+	LabelTypeFloat  = "float"
+	LabelTypeBool   = "bool"
+)
+
+var LabelTypes = []string{LabelTypeString, LabelTypeFloat, LabelTypeBool}
+
+var labelKeyPattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
+const maxLabelKeyLength = 63
+
+// ValidateLabelKey checks that a label key is at most 63 characters of
+// letters, digits, '-', '_' and '.', starting and ending with a letter or digit.
+func ValidateLabelKey(key string) error {
+	switch {
+	case key == "":
+		return &ValidationError{Message: "label key is empty"}
+	case len(key) > maxLabelKeyLength:
+		return &ValidationError{Message: fmt.Sprintf("label key %q is longer than %d characters", key, maxLabelKeyLength)}
+	case !labelKeyPattern.MatchString(key):
+		return &ValidationError{Message: fmt.Sprintf("label key %q may only contain letters, digits, '-', '_' and '.', and must start and end with a letter or digit", key)}
+	}
+	return nil
+}
+
+// ParseLabelType returns the label type named by name.
+func ParseLabelType(name string) (string, error) {
+	switch name {
+	case LabelTypeString, LabelTypeFloat, LabelTypeBool:
+		return name, nil
+	case "float64":
+		return LabelTypeFloat, nil
+	}
+	return "", &ValidationError{Message: fmt.Sprintf("unknown label type %q, expected one of %s", name, strings.Join(LabelTypes, ", "))}
+}
+
+// InferLabelType guesses the type of a label value given as text, trying
+// float, then bool, then string.
+func InferLabelType(value string) string {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return LabelTypeFloat
+	}
+	if _, err := strconv.ParseBool(value); err == nil {
+		return LabelTypeBool
+	}
+	return LabelTypeString
+}
+
+// LabelValueType names the type of a label value as returned by the gateway.
+func LabelValueType(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return LabelTypeString
+	case float64, float32, int, int64:
+		return LabelTypeFloat
+	case bool:
+		return LabelTypeBool
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// TypedLabelValue converts value to the Go type of a label value type. Text
+// is parsed for the float and bool types.
+func TypedLabelValue(valueType string, value interface{}) (interface{}, error) {
+	valueType, err := ParseLabelType(valueType)
+	if err != nil {
+		return nil, err
+	}
+	switch valueType {
+	case LabelTypeString:
+		switch v := value.(type) {
+		case nil:
+			return nil, &ValidationError{Message: "missing value"}
+		case string:
+			return v, nil
+		default:
+			return fmt.Sprint(v), nil
+		}
+	case LabelTypeFloat:
+		switch v := value.(type) {
+		case int:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		case string:
+			number, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, &ValidationError{Message: fmt.Sprintf("%q is not a float", v)}
+			}
+			return number, nil
+		}
+		return nil, &ValidationError{Message: fmt.Sprintf("%v is not a float", value)}
+	case LabelTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			boolean, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, &ValidationError{Message: fmt.Sprintf("%q is not a bool", v)}
+			}
+			return boolean, nil
+		}
+		return nil, &ValidationError{Message: fmt.Sprintf("%v is not a bool", value)}
+	}
+	return value, nil
+}
+
+// ReadLabelsManifest reads and validates a YAML or JSON labels manifest. The
+// label values are converted to their declared types.
+func ReadLabelsManifest(path string) (model.LabelsManifest, error) {
+	var manifest model.LabelsManifest
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return manifest, fmt.Errorf("failed to read file: %v", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil && !errors.Is(err, io.EOF) {
+		return manifest, &ManifestError{Path: path, Message: err.Error()}
+	}
+
+	if len(manifest.Targets) == 0 {
+		return manifest, &ManifestError{Path: path, Message: "no targets"}
+	}
+	for i := range manifest.Targets {
+		if err := validateLabelTarget(&manifest.Targets[i]); err != nil {
+			return manifest, &ManifestError{Path: path, Message: fmt.Sprintf("target %d: %v", i+1, err)}
+		}
+	}
+	return manifest, nil
+}
+
+func validateLabelTarget(target *model.LabelTarget) error {
+	if (len(target.NodeIDs) == 0) == (target.Query == "") {
+		return fmt.Errorf("exactly one of nodeIds and query is required")
+	}
+	if target.Query != "" {
+		if _, err := ParseNodeQuery(target.Query); err != nil {
+			return err
+		}
+	}
+	if len(target.Labels) == 0 && len(target.Remove) == 0 {
+		return fmt.Errorf("no labels to put or remove")
+	}
+
+	for i, label := range target.Labels {
+		if err := ValidateLabelKey(label.Key); err != nil {
+			return fmt.Errorf("label %d: %v", i+1, err)
+		}
+		valueType, err := ParseLabelType(label.Type)
+		if err != nil {
+			return fmt.Errorf("label %s: %v", label.Key, err)
+		}
+		value, err := TypedLabelValue(valueType, label.Value)
+		if err != nil {
+			return fmt.Errorf("label %s: %v", label.Key, err)
+		}
+		target.Labels[i].Type = valueType
+		target.Labels[i].Value = value
+	}
+	return nil
+}
+
+// PlanLabelChanges resolves the manifest targets against the nodes owned by
+// its organization and diffs the wanted labels with the current ones. Later
+// targets override earlier ones for the same node and key. Nodes are returned
+// in the order the targets first select them.
+func PlanLabelChanges(manifest model.LabelsManifest, owned []model.Node) ([]model.NodeLabelChanges, error) {
+	type wanted struct {
+		labels map[string]*model.TypedLabel
+		keys   []string
+	}
+
+	byID := make(map[string]model.Node, len(owned))
+	for _, node := range owned {
+		byID[node.ID] = node
+	}
+
+	var order []string
+	wants := map[string]*wanted{}
+	for _, target := range manifest.Targets {
+		nodes, err := targetNodes(target, owned, byID, manifest.Org)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			want, ok := wants[node.ID]
+			if !ok {
+				want = &wanted{labels: map[string]*model.TypedLabel{}}
+				wants[node.ID] = want
+				order = append(order, node.ID)
+			}
+			set := func(key string, label *model.TypedLabel) {
+				if _, seen := want.labels[key]; !seen {
+					want.keys = append(want.keys, key)
+				}
+				want.labels[key] = label
+			}
+			for i := range target.Labels {
+				set(target.Labels[i].Key, &target.Labels[i])
+			}
+			for _, key := range target.Remove {
+				set(key, nil)
+			}
+		}
+	}
+
+	plan := make([]model.NodeLabelChanges, 0, len(order))
+	for _, id := range order {
+		current := nodeLabels(byID[id])
+		changes := model.NodeLabelChanges{NodeID: id}
+		want := wants[id]
+		for _, key := range want.keys {
+			value, present := current[key]
+			label := want.labels[key]
+			switch {
+			case label == nil && present:
+				changes.Delete = append(changes.Delete, key)
+			case label == nil:
+			case present && labelText(value) == labelText(label.Value):
+				changes.Unchanged++
+			default:
+				changes.Put = append(changes.Put, *label)
+			}
+		}
+		plan = append(plan, changes)
+	}
+	return plan, nil
+}
+
+func targetNodes(target model.LabelTarget, owned []model.Node, byID map[string]model.Node, org string) ([]model.Node, error) {
+	if target.Query != "" {
+		filter, err := ParseNodeQuery(target.Query)
+		if err != nil {
+			return nil, err
+		}
+		return filter.FilterAll(owned), nil
+	}
+
+	nodes := make([]model.Node, 0, len(target.NodeIDs))
+	for _, id := range target.NodeIDs {
+		node, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("node %s %w among the nodes owned by %s", id, ErrNotFound, org)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func TestPlanLabelChanges(t *testing.T) {
+	owned := []model.Node{
+		{ID: "n1", Labels: []model.Label{
+			{Key: "env", Value: "prod"},
+			{Key: "weight", Value: 1.5},
+			{Key: "cores", Value: 4.0},
+			{Key: "gpu", Value: true},
+			{Key: "zones", Value: []interface{}{"a", "b"}},
+		}},
+	}
+
+	tests := []struct {
+		name          string
+		labels        []model.TypedLabel
+		remove        []string
+		wantPut       []string
+		wantDelete    []string
+		wantUnchanged int
+	}{
+		{"same string", []model.TypedLabel{{Key: "env", Type: LabelTypeString, Value: "prod"}}, nil, nil, nil, 1},
+		{"different string", []model.TypedLabel{{Key: "env", Type: LabelTypeString, Value: "dev"}}, nil, []string{"env"}, nil, 0},
+		{"same float", []model.TypedLabel{{Key: "weight", Type: LabelTypeFloat, Value: 1.5}}, nil, nil, nil, 1},
+		{"whole number read as int", []model.TypedLabel{{Key: "cores", Type: LabelTypeFloat, Value: 4}}, nil, nil, nil, 1},
+		{"different float", []model.TypedLabel{{Key: "cores", Type: LabelTypeFloat, Value: 8.0}}, nil, []string{"cores"}, nil, 0},
+		{"same bool", []model.TypedLabel{{Key: "gpu", Type: LabelTypeBool, Value: true}}, nil, nil, nil, 1},
+		{"non-comparable current value", []model.TypedLabel{{Key: "zones", Type: LabelTypeString, Value: "a"}}, nil, []string{"zones"}, nil, 0},
+		{"missing label", []model.TypedLabel{{Key: "rack", Type: LabelTypeString, Value: "r1"}}, nil, []string{"rack"}, nil, 0},
+		{"remove present label", nil, []string{"env"}, nil, []string{"env"}, 0},
+		{"remove missing label", nil, []string{"rack"}, nil, nil, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest := model.LabelsManifest{Org: "c12s", Targets: []model.LabelTarget{
+				{NodeIDs: []string{"n1"}, Labels: test.labels, Remove: test.remove},
+			}}
+			plan, err := PlanLabelChanges(manifest, owned)
+			if err != nil {
+				t.Fatalf("PlanLabelChanges: %v", err)
+			}
+			if len(plan) != 1 {
+				t.Fatalf("plan has %d nodes, want 1", len(plan))
+			}
+
+			var put []string
+			for _, label := range plan[0].Put {
+				put = append(put, label.Key)
+			}
+			if !reflect.DeepEqual(put, test.wantPut) || !reflect.DeepEqual(plan[0].Delete, test.wantDelete) || plan[0].Unchanged != test.wantUnchanged {
+				t.Errorf("plan = put %v, delete %v, unchanged %d, want put %v, delete %v, unchanged %d",
+					put, plan[0].Delete, plan[0].Unchanged, test.wantPut, test.wantDelete, test.wantUnchanged)
+			}
+		})
+	}
+}
+
+func TestValidateLabelTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  model.LabelTarget
+		wantErr bool
+	}{
+		{"valid put", model.LabelTarget{NodeIDs: []string{"n1"}, Labels: []model.TypedLabel{{Key: "env", Type: LabelTypeString, Value: "prod"}}}, false},
+		{"invalid key put", model.LabelTarget{NodeIDs: []string{"n1"}, Labels: []model.TypedLabel{{Key: "bad key", Type: LabelTypeString, Value: "x"}}}, true},
+		{"invalid key removed", model.LabelTarget{NodeIDs: []string{"n1"}, Remove: []string{"bad key", "-legacy"}}, false},
+		{"nothing to do", model.LabelTarget{NodeIDs: []string{"n1"}}, true},
+		{"both node IDs and query", model.LabelTarget{NodeIDs: []string{"n1"}, Query: "gpu", Remove: []string{"env"}}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := validateLabelTarget(&test.target); (err != nil) != test.wantErr {
+				t.Errorf("validateLabelTarget = %v, want error %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/c12s/cockpit/model"
+	"gopkg.in/yaml.v3"
+)
+
+var manifestExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// ReadManifests reads the documents of YAML or JSON manifest files, several
+// per file when separated by ---. A path may name a file, a directory whose
+// .yaml, .yml and .json files are read in name order, or - for stdin.
+func ReadManifests(paths []string, stdin io.Reader) ([]model.Manifest, error) {
+	var manifests []model.Manifest
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			var content []byte
+			if file == "-" {
+				content, err = io.ReadAll(stdin)
+			} else {
+				content, err = os.ReadFile(file)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to read file: %v", err)
+			}
+
+			documents, err := parseManifests(file, content)
+			if err != nil {
+				return nil, err
+			}
+			manifests = append(manifests, documents...)
+		}
+	}
+	if len(manifests) == 0 {
+		return nil, &ManifestError{Path: strings.Join(paths, ", "), Message: "no documents"}
+	}
+	return manifests, nil
+}
+
+func manifestFiles(path string) ([]string, error) {
+	if path == "-" {
+		return []string{path}, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %v", err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %v", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && manifestExtensions[filepath.Ext(entry.Name())] {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+func parseManifests(file string, content []byte) ([]model.Manifest, error) {
+	if file == "-" {
+		file = "stdin"
+	}
+
+	var manifests []model.Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for index := 1; ; index++ {
+		var document map[string]interface{}
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			return manifests, nil
+		}
+		source := fmt.Sprintf("%s#%d", file, index)
+		if err != nil {
+			return nil, &ManifestError{Path: source, Message: err.Error()}
+		}
+		if document == nil {
+			continue
+		}
+
+		kind, err := manifestKind(document["kind"])
+		if err != nil {
+			return nil, &ManifestError{Path: source, Message: err.Error()}
+		}
+		delete(document, "kind")
+		manifests = append(manifests, model.Manifest{Kind: kind, Source: source, Spec: document})
+	}
+}
+
+func manifestKind(value interface{}) (string, error) {
+	name, _ := value.(string)
+	if name == "" {
+		return "", fmt.Errorf("missing kind, expected one of %s", strings.Join(model.ManifestKinds, ", "))
+	}
+	for _, kind := range model.ManifestKinds {
+		if strings.EqualFold(name, kind) {
+			return kind, nil
+		}
+	}
+	return "", fmt.Errorf("unknown kind %q, expected one of %s", name, strings.Join(model.ManifestKinds, ", "))
+}
+
+// SortManifests orders manifests by the dependencies between their kinds,
+// keeping the file order within a kind. Reversed, dependents come first.
+func SortManifests(manifests []model.Manifest, reverse bool) {
+	order := make(map[string]int, len(model.ManifestKinds))
+	for i, kind := range model.ManifestKinds {
+		order[kind] = i
+		if reverse {
+			order[kind] = -i
+		}
+	}
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return order[manifests[i].Kind] < order[manifests[j].Kind]
+	})
+}
+
+// DecodeManifest converts the spec of a manifest into out, following the
+// json tags the request models use.
+func DecodeManifest(manifest model.Manifest, out interface{}) error {
+	data, err := json.Marshal(manifest.Spec)
+	if err != nil {
+		return &ManifestError{Path: manifest.Source, Message: err.Error()}
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return &ManifestError{Path: manifest.Source, Message: fmt.Sprintf("invalid %s: %v", manifest.Kind, err)}
+	}
+	return nil
+}
+
+// ManifestResultKind is the lower-case kind used when reporting results.
+func ManifestResultKind(manifest model.Manifest) string {
+	return strings.ToLower(manifest.Kind)
+}
+
+// ManifestName identifies the object a manifest describes, e.g.
+// org/namespace/name@version for configs.
+func ManifestName(manifest model.Manifest) string {
+	spec := manifest.Spec
+	switch manifest.Kind {
+	case model.KindNamespace:
+		return DocumentName(spec, "orgId", "name")
+	case model.KindApp:
+		return DocumentName(spec, "orgId", "namespace", "name")
+	case model.KindSchema:
+		var document model.SchemaDocument
+		if DecodeManifest(manifest, &document) == nil {
+			return SchemaName(document.SchemaDetails)
+		}
+	case model.KindConfigGroup, model.KindStandaloneConfig:
+		return configName(DocumentName(spec, "organization", "namespace", "name"), spec["version"])
+	case model.KindConfigGroupPlacement, model.KindStandaloneConfigPlacement:
+		if config, ok := spec["config"].(map[string]interface{}); ok {
+			return configName(DocumentName(config, "organization", "namespace", "name"), config["version"])
+		}
+	case model.KindPolicy:
+		var policy model.PoliciesRequest
+		if DecodeManifest(manifest, &policy) == nil {
+			return fmt.Sprintf("%s:%s/%s/%s:%s", policy.SubjectScope.Kind, policy.SubjectScope.ID, policy.Permission.Name, policy.ObjectScope.Kind, policy.ObjectScope.ID)
+		}
+	case model.KindRelation:
+		var relation model.Relation
+		if DecodeManifest(manifest, &relation) == nil {
+			return fmt.Sprintf("%s:%s/%s:%s", relation.From.Kind, relation.From.ID, relation.To.Kind, relation.To.ID)
+		}
+	}
+	return manifest.Source
+}
+
+func configName(name string, version interface{}) string {
+	if version, ok := version.(string); ok && version != "" {
+		return name + "@" + version
+	}
+	return name
+}
@@ -1,30 +1,591 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/c12s/cockpit/model"
+	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// serverOperators are the comparisons the gateway evaluates itself. The
+// gateway joins the queries it receives with and.
+var serverOperators = map[string]bool{"=": true, "!=": true, ">": true, "<": true}
+
+// NodeFilter is a parsed node query. Server holds the and-combined comparisons
+// the gateway can evaluate; the rest of the expression is applied to the
+// returned nodes by Filter.
+type NodeFilter struct {
+	Server []model.NodeQuery
+	client nodeExpr
+	query  nodeExpr
+}
+
+// QueryError reports an invalid node query or label selector, with the
+// 1-based column of a syntax error when Column is set.
+type QueryError struct {
+	Query   string
+	Column  int
+	Message string
+	// Subject names what was invalid, "query" when empty.
+	Subject string
+}
+
+func (e *QueryError) Error() string {
+	subject := e.Subject
+	if subject == "" {
+		subject = "query"
+	}
+	if e.Column == 0 {
+		return fmt.Sprintf("invalid %s: %s", subject, e.Message)
+	}
+	return fmt.Sprintf("invalid %s at column %d: %s\n  %s\n  %s^", subject, e.Column, e.Message, e.Query, strings.Repeat(" ", e.Column-1))
+}
+
+// ParseNodeQuery parses expressions such as
+//
+//	cpu-cores >= 4 and (memory-totalGB > 8 or disk-freeGB > 100) and region in [eu, us]
+//
+// Comparisons use =, ==, !=, >, >=, < and <=, can be combined with and, or,
+// not and parentheses, and `key in [a, b]` matches any of the listed values.
+// A bare key matches the nodes that have the label, whatever its value.
+// Values are numbers, true/false or strings; quote strings containing spaces.
+// An empty query matches every node.
+func ParseNodeQuery(query string) (*NodeFilter, error) {
+	tokens, err := tokenizeNodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 1 {
+		return &NodeFilter{}, nil
+	}
+
+	p := &queryParser{query: query, tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorAt(tok, fmt.Sprintf("unexpected %s", tok.describe()))
+	}
+
+	filter := &NodeFilter{query: expr}
+	var rest []nodeExpr
+	for _, term := range conjuncts(expr) {
+		if pred, ok := term.(*predicate); ok && pred.serverSide() {
+			filter.Server = append(filter.Server, model.NodeQuery{
+				LabelKey: pred.key,
+				ShouldBe: pred.serverOperator(),
+				Value:    pred.values[0].text,
+			})
+			continue
+		}
+		rest = append(rest, term)
+	}
+	switch len(rest) {
+	case 0:
+	case 1:
+		filter.client = rest[0]
+	default:
+		filter.client = andExpr(rest)
+	}
+	return filter, nil
+}
+
+// ServerOnly reports whether the gateway evaluates the whole query.
+func (f *NodeFilter) ServerOnly() bool {
+	return f.client == nil
+}
+
+// RequireServerOnly fails when part of the query would have to be evaluated
+// client-side, for requests where the gateway acts on the matching nodes.
+func (f *NodeFilter) RequireServerOnly(query string) error {
+	if f.client == nil {
+		return nil
+	}
+	return &QueryError{
+		Query:   query,
+		Message: fmt.Sprintf("the gateway can only evaluate and-combined =, !=, > and < comparisons, not %s", f.client),
+	}
+}
+
+// Filter returns the nodes matching the client-side part of the query.
+func (f *NodeFilter) Filter(nodes []model.Node) []model.Node {
+	return filterNodes(nodes, f.client)
+}
+
+// FilterAll returns the nodes matching the whole query, for nodes listed
+// without sending the server-side part.
+func (f *NodeFilter) FilterAll(nodes []model.Node) []model.Node {
+	return filterNodes(nodes, f.query)
+}
+
+func filterNodes(nodes []model.Node, expr nodeExpr) []model.Node {
+	if expr == nil {
+		return nodes
+	}
+	matching := []model.Node{}
+	for _, node := range nodes {
+		if expr.match(nodeLabels(node)) {
+			matching = append(matching, node)
+		}
+	}
+	return matching
+}
+
+func nodeLabels(node model.Node) map[string]interface{} {
+	labels := make(map[string]interface{}, len(node.Labels))
+	for _, label := range node.Labels {
+		labels[label.Key] = label.Value
+	}
+	return labels
+}
+
+type nodeExpr interface {
+	match(labels map[string]interface{}) bool
+	String() string
+}
+
+type andExpr []nodeExpr
+
+func (e andExpr) match(labels map[string]interface{}) bool {
+	for _, term := range e {
+		if !term.match(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e andExpr) String() string {
+	return joinExprs(e, " and ")
+}
+
+type orExpr []nodeExpr
+
+func (e orExpr) match(labels map[string]interface{}) bool {
+	for _, term := range e {
+		if term.match(labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e orExpr) String() string {
+	return "(" + joinExprs(e, " or ") + ")"
+}
+
+type notExpr struct {
+	expr nodeExpr
+}
+
+func (e notExpr) match(labels map[string]interface{}) bool {
+	return !e.expr.match(labels)
+}
+
+func (e notExpr) String() string {
+	return "not " + e.expr.String()
+}
+
+func joinExprs(exprs []nodeExpr, separator string) string {
+	parts := make([]string, len(exprs))
+	for i, expr := range exprs {
+		parts[i] = expr.String()
+	}
+	return strings.Join(parts, separator)
+}
+
+func conjuncts(expr nodeExpr) []nodeExpr {
+	if and, ok := expr.(andExpr); ok {
+		return and
+	}
+	return []nodeExpr{expr}
+}
+
+type queryValue struct {
+	text   string
+	quoted bool
+}
+
+// number returns the value as a number unless it was quoted.
+func (v queryValue) number() (float64, bool) {
+	if v.quoted {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(v.text, 64)
+	return number, err == nil
+}
+
+func (v queryValue) String() string {
+	if v.quoted || v.text == "" || strings.IndexFunc(v.text, isQuerySpecial) >= 0 {
+		return strconv.Quote(v.text)
+	}
+	return v.text
+}
+
+type predicate struct {
+	key      string
+	operator string
+	values   []queryValue
+}
+
+func (p *predicate) serverSide() bool {
+	return serverOperators[p.serverOperator()]
+}
+
+func (p *predicate) serverOperator() string {
+	if p.operator == "==" {
+		return "="
+	}
+	return p.operator
+}
+
+func (p *predicate) match(labels map[string]interface{}) bool {
+	label, ok := labels[p.key]
+	if p.operator == "exists" {
+		return ok
+	}
+	if !ok {
+		return false
+	}
+
+	switch p.operator {
+	case "in":
+		for _, value := range p.values {
+			if compareLabel(label, value) == 0 {
+				return true
+			}
+		}
+		return false
+	case "not in":
+		for _, value := range p.values {
+			if compareLabel(label, value) == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	cmp := compareLabel(label, p.values[0])
+	switch p.operator {
+	case "=", "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	}
+	if cmp == incomparable {
+		return false
+	}
+	switch p.operator {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+func (p *predicate) String() string {
+	if p.operator == "exists" {
+		return p.key
+	}
+	if p.operator == "in" || p.operator == "not in" {
+		values := make([]string, len(p.values))
+		for i, value := range p.values {
+			values[i] = value.String()
+		}
+		return fmt.Sprintf("%s %s [%s]", p.key, p.operator, strings.Join(values, ", "))
+	}
+	return fmt.Sprintf("%s %s %s", p.key, p.operator, p.values[0])
+}
+
+const incomparable = 2
+
+// compareLabel compares a label value with a query value, numerically when
+// both are numbers and as text otherwise. It returns incomparable when a
+// number is compared with a value that is not one.
+func compareLabel(label interface{}, value queryValue) int {
+	text := labelText(label)
+	if number, ok := value.number(); ok {
+		labelNumber, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return incomparable
+		}
+		switch {
+		case labelNumber < number:
+			return -1
+		case labelNumber > number:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(text, value.text)
+}
+
+func labelText(label interface{}) string {
+	switch v := label.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		return v.String()
+	}
+	return fmt.Sprint(label)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
 )
 
-func CreateNodeQuery(query string) ([]model.NodeQuery, error) {
-	if query == "" {
-		return nil, nil
+type queryToken struct {
+	kind   tokenKind
+	text   string
+	column int
+}
+
+func (t queryToken) keyword(word string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, word)
+}
+
+// reserved reports whether the token is a keyword, which has to be quoted to
+// be used as a value.
+func (t queryToken) reserved() bool {
+	return t.keyword("and") || t.keyword("or") || t.keyword("not") || t.keyword("in")
+}
+
+func (t queryToken) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return strconv.Quote(t.text)
+	}
+	return "'" + t.text + "'"
+}
+
+func isQuerySpecial(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("()[],=!<>'\"&|", r)
+}
+
+func tokenizeNodeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		column := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')' || r == '[' || r == ']' || r == ',':
+			kinds := map[rune]tokenKind{'(': tokenLParen, ')': tokenRParen, '[': tokenLBracket, ']': tokenRBracket, ',': tokenComma}
+			tokens = append(tokens, queryToken{kind: kinds[r], text: string(r), column: column})
+			i++
+		case r == '\'' || r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, &QueryError{Query: query, Column: column, Message: "unterminated string"}
+			}
+			tokens = append(tokens, queryToken{kind: tokenString, text: string(runes[i+1 : end]), column: column})
+			i = end + 1
+		case r == '&' || r == '|':
+			if i+1 >= len(runes) || runes[i+1] != r {
+				return nil, &QueryError{Query: query, Column: column, Message: fmt.Sprintf("unexpected '%c', use %c%c", r, r, r)}
+			}
+			word := "and"
+			if r == '|' {
+				word = "or"
+			}
+			tokens = append(tokens, queryToken{kind: tokenWord, text: word, column: column})
+			i += 2
+		case strings.ContainsRune("=!<>", r):
+			operator := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				operator += "="
+			}
+			if operator == "!" {
+				return nil, &QueryError{Query: query, Column: column, Message: "unexpected '!', use != or not"}
+			}
+			tokens = append(tokens, queryToken{kind: tokenOperator, text: operator, column: column})
+			i += len(operator)
+		default:
+			end := i
+			for end < len(runes) && !isQuerySpecial(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, queryToken{kind: tokenWord, text: string(runes[i:end]), column: column})
+			i = end
+		}
 	}
+	return append(tokens, queryToken{kind: tokenEOF, column: len(runes) + 1}), nil
+}
+
+type queryParser struct {
+	query  string
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
 
-	parts := strings.Fields(query)
-	if len(parts) != 3 {
-		return nil, fmt.Errorf("invalid query format. Please use 'key operation value'")
+func (p *queryParser) next() queryToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
 	}
+	return tok
+}
 
-	labelKey := parts[0]
-	shouldBe := parts[1]
-	value := parts[2]
+func (p *queryParser) errorAt(tok queryToken, message string) error {
+	return &QueryError{Query: p.query, Column: tok.column, Message: message}
+}
 
-	nodeQuery := model.NodeQuery{
-		LabelKey: labelKey,
-		ShouldBe: shouldBe,
-		Value:    value,
+func (p *queryParser) parseOr() (nodeExpr, error) {
+	expr, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	terms := []nodeExpr{expr}
+	for p.peek().keyword("or") {
+		p.next()
+		expr, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, expr)
 	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return orExpr(terms), nil
+}
 
-	return []model.NodeQuery{nodeQuery}, nil
+func (p *queryParser) parseAnd() (nodeExpr, error) {
+	expr, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	terms := conjuncts(expr)
+	for p.peek().keyword("and") {
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, conjuncts(expr)...)
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return andExpr(terms), nil
+}
+
+func (p *queryParser) parseUnary() (nodeExpr, error) {
+	tok := p.peek()
+	switch {
+	case tok.keyword("not"):
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	case tok.kind == tokenLParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, p.errorAt(closing, fmt.Sprintf("expected ')' to close the '(' at column %d, found %s", tok.column, closing.describe()))
+		}
+		return expr, nil
+	}
+	return p.parsePredicate()
+}
+
+func (p *queryParser) parsePredicate() (nodeExpr, error) {
+	key := p.next()
+	if (key.kind != tokenWord || key.reserved()) && key.kind != tokenString {
+		return nil, p.errorAt(key, fmt.Sprintf("expected a label key, found %s", key.describe()))
+	}
+
+	// A bare key matches the nodes that have the label.
+	if next := p.peek(); next.kind == tokenEOF || next.kind == tokenRParen || next.keyword("and") || next.keyword("or") {
+		return &predicate{key: key.text, operator: "exists"}, nil
+	}
+
+	operator := p.next()
+	switch {
+	case operator.kind == tokenOperator:
+		value, err := p.parseValue(operator.text)
+		if err != nil {
+			return nil, err
+		}
+		return &predicate{key: key.text, operator: operator.text, values: []queryValue{value}}, nil
+	case operator.keyword("in"):
+		return p.parseIn(key.text, "in")
+	case operator.keyword("not") && p.peek().keyword("in"):
+		p.next()
+		return p.parseIn(key.text, "not in")
+	}
+	return nil, p.errorAt(operator, fmt.Sprintf("expected an operator (=, !=, >, >=, <, <=, in) after %q, found %s", key.text, operator.describe()))
+}
+
+func (p *queryParser) parseIn(key, operator string) (nodeExpr, error) {
+	if open := p.next(); open.kind != tokenLBracket {
+		return nil, p.errorAt(open, fmt.Sprintf("expected '[' after %q, found %s", operator, open.describe()))
+	}
+
+	var values []queryValue
+	for {
+		value, err := p.parseValue(operator)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		tok := p.next()
+		if tok.kind == tokenRBracket {
+			break
+		}
+		if tok.kind != tokenComma {
+			return nil, p.errorAt(tok, fmt.Sprintf("expected ',' or ']', found %s", tok.describe()))
+		}
+	}
+	return &predicate{key: key, operator: operator, values: values}, nil
+}
+
+func (p *queryParser) parseValue(after string) (queryValue, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenWord && !tok.reserved():
+		return queryValue{text: tok.text}, nil
+	case tok.kind == tokenString:
+		return queryValue{text: tok.text, quoted: true}, nil
+	}
+	return queryValue{}, p.errorAt(tok, fmt.Sprintf("expected a value after %q, found %s", after, tok.describe()))
 }
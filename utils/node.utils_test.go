@@ -0,0 +1,225 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/c12s/cockpit/model"
+)
+
+func testNodes() []model.Node {
+	return []model.Node{
+		{ID: "n1", Labels: []model.Label{{Key: "cpu-cores", Value: 4.0}, {Key: "memory-totalGB", Value: 16.0}, {Key: "region", Value: "eu"}, {Key: "env", Value: "prod"}}},
+		{ID: "n2", Labels: []model.Label{{Key: "cpu-cores", Value: 2.0}, {Key: "memory-totalGB", Value: 8.0}, {Key: "region", Value: "us"}, {Key: "env", Value: "dev"}}},
+		{ID: "n3", Labels: []model.Label{{Key: "cpu-cores", Value: 8.0}, {Key: "memory-totalGB", Value: 32.0}, {Key: "region", Value: "asia"}, {Key: "gpu", Value: true}}},
+		{ID: "n4", Labels: []model.Label{{Key: "cpu-cores", Value: "16"}, {Key: "region", Value: "eu"}, {Key: "env", Value: "staging"}}},
+	}
+}
+
+func nodeIDs(nodes []model.Node) []string {
+	ids := []string{}
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
+func TestParseNodeQueryMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"empty query", "", []string{"n1", "n2", "n3", "n4"}},
+		{"numeric comparison", "cpu-cores > 2", []string{"n1", "n3", "n4"}},
+		{"numeric label sent as text", "cpu-cores >= 16", []string{"n4"}},
+		{"double equals", "region == eu", []string{"n1", "n4"}},
+		{"quoted value", "region = 'eu'", []string{"n1", "n4"}},
+		{"not equals skips nodes without the label", "env != prod", []string{"n2", "n4"}},
+		{"and binds tighter than or", "cpu-cores > 2 or region = us and env = prod", []string{"n1", "n3", "n4"}},
+		{"parentheses", "(cpu-cores > 2 or region = us) and env = prod", []string{"n1"}},
+		{"not binds tighter than and", "not env = prod and cpu-cores >= 4", []string{"n3", "n4"}},
+		{"not with parentheses", "not (env = prod or env = dev)", []string{"n3", "n4"}},
+		{"double not", "not not region = eu", []string{"n1", "n4"}},
+		{"symbolic operators", "region = eu && cpu-cores > 4 || gpu", []string{"n3", "n4"}},
+		{"keywords ignore case", "region = eu AND NOT env = prod", []string{"n4"}},
+		{"in", "region in [eu, us]", []string{"n1", "n2", "n4"}},
+		{"not in", "region not in [eu, us]", []string{"n3"}},
+		{"in with numbers", "cpu-cores in [2, 8]", []string{"n2", "n3"}},
+		{"bare key", "gpu", []string{"n3"}},
+		{"bare key in parentheses", "(gpu)", []string{"n3"}},
+		{"bare key with and", "gpu and region = asia", []string{"n3"}},
+		{"bare key with or", "env = dev or gpu", []string{"n2", "n3"}},
+		{"negated bare key", "not gpu", []string{"n1", "n2", "n4"}},
+		{"missing bare key", "tpu", []string{}},
+		{"number compared with text", "region > 2", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := ParseNodeQuery(test.query)
+			if err != nil {
+				t.Fatalf("ParseNodeQuery(%q): %v", test.query, err)
+			}
+			if got := nodeIDs(filter.FilterAll(testNodes())); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("FilterAll(%q) = %v, want %v", test.query, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseNodeQueryServerSplit(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantServer []model.NodeQuery
+		wantClient string
+	}{
+		{
+			name:  "and-combined comparisons go to the gateway",
+			query: "cpu-cores > 2 and region = eu and env != dev and memory-totalGB < 64",
+			wantServer: []model.NodeQuery{
+				{LabelKey: "cpu-cores", ShouldBe: ">", Value: "2"},
+				{LabelKey: "region", ShouldBe: "=", Value: "eu"},
+				{LabelKey: "env", ShouldBe: "!=", Value: "dev"},
+				{LabelKey: "memory-totalGB", ShouldBe: "<", Value: "64"},
+			},
+		},
+		{
+			name:       "double equals is sent as equals",
+			query:      "region == 'eu west'",
+			wantServer: []model.NodeQuery{{LabelKey: "region", ShouldBe: "=", Value: "eu west"}},
+		},
+		{
+			name:       "inclusive comparisons are filtered locally",
+			query:      "cpu-cores >= 4 and region = eu",
+			wantServer: []model.NodeQuery{{LabelKey: "region", ShouldBe: "=", Value: "eu"}},
+			wantClient: "cpu-cores >= 4",
+		},
+		{
+			name:       "or is filtered locally",
+			query:      "region = eu or region = us",
+			wantClient: "(region = eu or region = us)",
+		},
+		{
+			name:       "or next to server comparisons",
+			query:      "cpu-cores > 2 and (region = eu or gpu)",
+			wantServer: []model.NodeQuery{{LabelKey: "cpu-cores", ShouldBe: ">", Value: "2"}},
+			wantClient: "(region = eu or gpu)",
+		},
+		{
+			name:       "not is filtered locally",
+			query:      "not region = eu and env = prod",
+			wantServer: []model.NodeQuery{{LabelKey: "env", ShouldBe: "=", Value: "prod"}},
+			wantClient: "not region = eu",
+		},
+		{
+			name:       "in is filtered locally",
+			query:      "region in [eu, 'us east']",
+			wantClient: `region in [eu, "us east"]`,
+		},
+		{
+			name:       "bare keys are filtered locally",
+			query:      "gpu and cpu-cores > 4",
+			wantServer: []model.NodeQuery{{LabelKey: "cpu-cores", ShouldBe: ">", Value: "4"}},
+			wantClient: "gpu",
+		},
+		{
+			name:       "several local terms",
+			query:      "gpu and cpu-cores <= 8 and region = asia",
+			wantServer: []model.NodeQuery{{LabelKey: "region", ShouldBe: "=", Value: "asia"}},
+			wantClient: "gpu and cpu-cores <= 8",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := ParseNodeQuery(test.query)
+			if err != nil {
+				t.Fatalf("ParseNodeQuery(%q): %v", test.query, err)
+			}
+			if !reflect.DeepEqual(filter.Server, test.wantServer) {
+				t.Errorf("Server = %v, want %v", filter.Server, test.wantServer)
+			}
+
+			client := ""
+			if filter.client != nil {
+				client = filter.client.String()
+			}
+			if client != test.wantClient {
+				t.Errorf("client part = %q, want %q", client, test.wantClient)
+			}
+			if filter.ServerOnly() != (test.wantClient == "") {
+				t.Errorf("ServerOnly() = %v, want %v", filter.ServerOnly(), test.wantClient == "")
+			}
+
+			err = filter.RequireServerOnly(test.query)
+			if test.wantClient == "" && err != nil {
+				t.Errorf("RequireServerOnly: %v", err)
+			}
+			if test.wantClient != "" && (err == nil || !strings.Contains(err.Error(), test.wantClient)) {
+				t.Errorf("RequireServerOnly error = %v, want it to name %q", err, test.wantClient)
+			}
+		})
+	}
+}
+
+func TestNodeFilterAppliesOnlyTheLocalPart(t *testing.T) {
+	filter, err := ParseNodeQuery("region = eu and cpu-cores >= 8")
+	if err != nil {
+		t.Fatalf("ParseNodeQuery: %v", err)
+	}
+	if got, want := nodeIDs(filter.Filter(testNodes())), []string{"n3", "n4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter = %v, want %v", got, want)
+	}
+	if got, want := nodeIDs(filter.FilterAll(testNodes())), []string{"n4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterAll = %v, want %v", got, want)
+	}
+}
+
+func TestParseNodeQueryErrors(t *testing.T) {
+	tests := []struct {
+		query   string
+		column  int
+		message string
+	}{
+		{"cpu-cores >", 12, `expected a value after ">", found end of query`},
+		{"cpu-cores > 2 and", 18, "expected a label key, found end of query"},
+		{"(cpu-cores > 2", 15, "expected ')' to close the '(' at column 1, found end of query"},
+		{"cpu-cores > 2)", 14, "unexpected ')'"},
+		{"cpu-cores > 2 region = eu", 15, "unexpected 'region'"},
+		{"cpu-cores ! 2", 11, "unexpected '!', use != or not"},
+		{"region = 'eu", 10, "unterminated string"},
+		{"region = eu & env = prod", 13, "unexpected '&', use &&"},
+		{"region in eu", 11, `expected '[' after "in", found 'eu'`},
+		{"region in [eu us]", 15, "expected ',' or ']', found 'us'"},
+		{"region in [eu,]", 15, `expected a value after "in", found ']'`},
+		{"and = 1", 1, "expected a label key, found 'and'"},
+		{"region = and", 10, `expected a value after "=", found 'and'`},
+		{"region eu", 8, `expected an operator (=, !=, >, >=, <, <=, in) after "region", found 'eu'`},
+		{"not", 4, "expected a label key, found end of query"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			_, err := ParseNodeQuery(test.query)
+			var queryErr *QueryError
+			if !errors.As(err, &queryErr) {
+				t.Fatalf("ParseNodeQuery(%q) error = %v, want a QueryError", test.query, err)
+			}
+			if queryErr.Column != test.column || queryErr.Message != test.message {
+				t.Errorf("ParseNodeQuery(%q) = column %d %q, want column %d %q", test.query, queryErr.Column, queryErr.Message, test.column, test.message)
+			}
+		})
+	}
+}
+
+func TestQueryErrorPointsAtColumn(t *testing.T) {
+	_, err := ParseNodeQuery("cpu-cores >")
+	want := "invalid query at column 12: expected a value after \">\", found end of query\n  cpu-cores >\n             ^"
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"sort"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// SelectNodes orders nodes by the sortBy label (see SortNodes), with the node
+// ID breaking ties, and keeps the first limit of them when limit is positive.
+func SelectNodes(nodes []model.Node, sortBy string, limit int) []model.Node {
+	selected := append([]model.Node(nil), nodes...)
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].ID < selected[j].ID
+	})
+	if sortBy != "" {
+		SortNodes(selected, sortBy)
+	}
+	if limit > 0 && len(selected) > limit {
+		selected = selected[:limit]
+	}
+	return selected
+}
+
+// SummarizeOwnership compares the nodes selected for a claim or release with
+// the nodes the gateway reported as changed.
+func SummarizeOwnership(action, org string, selected, changed []model.Node) model.OwnershipSummary {
+	summary := model.OwnershipSummary{
+		Action:     action,
+		Org:        org,
+		Nodes:      changed,
+		Skipped:    []string{},
+		Unselected: []string{},
+	}
+	if summary.Nodes == nil {
+		summary.Nodes = []model.Node{}
+	}
+
+	changedIDs := make(map[string]bool, len(changed))
+	for _, node := range changed {
+		changedIDs[node.ID] = true
+	}
+	selectedIDs := make(map[string]bool, len(selected))
+	for _, node := range selected {
+		selectedIDs[node.ID] = true
+		if !changedIDs[node.ID] {
+			summary.Skipped = append(summary.Skipped, node.ID)
+		}
+	}
+	for _, node := range changed {
+		if !selectedIDs[node.ID] {
+			summary.Unselected = append(summary.Unselected, node.ID)
+		}
+	}
+	return summary
+}
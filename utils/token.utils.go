@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/c12s/cockpit/model"
+)
+
+// DecodeTokenClaims reads the claims of a JWT without verifying its signature;
+// the gateway remains the authority on whether a token is valid.
+func DecodeTokenClaims(token string) (model.TokenClaims, error) {
+	var claims model.TokenClaims
+
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return claims, fmt.Errorf("token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return claims, fmt.Errorf("failed to decode token payload: %v", err)
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, fmt.Errorf("failed to parse token claims: %v", err)
+	}
+	return claims, nil
+}
@@ -2,179 +2,52 @@ package utils
 
 import (
 	"bufio"
-	"bytes"
-	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"syscall"
 
-	"github.com/c12s/cockpit/model"
-	"github.com/cheggaaa/pb/v3"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 	"gopkg.in/yaml.v3"
 )
 
-const (
-	tokenFilePath = "token.txt"
-)
-
-func SendHTTPRequest(config model.HTTPRequestConfig) error {
-	var requestBody []byte
-	var err error
-	if config.RequestBody != nil {
-		requestBody, err = json.Marshal(config.RequestBody)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %v", err)
-		}
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, config.Method, config.URL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	if config.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+config.Token)
-	}
-	for key, value := range config.Headers {
-		req.Header.Set(key, value)
-	}
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("request failed with status %s", string(bodyBytes))
-	}
-
-	if config.Response != nil {
-		if err := json.Unmarshal(bodyBytes, config.Response); err != nil {
-			return fmt.Errorf("failed to decode response: %v", err)
-		}
-	}
-
-	return nil
+func PromptForPassword() (string, error) {
+	return PromptForSecret("Enter password: ")
 }
 
-func SendHTTPRequestWithProgress(config model.HTTPRequestConfig, bar *pb.ProgressBar) error {
-	var requestBody []byte
-	var err error
-	if config.RequestBody != nil {
-		requestBody, err = json.Marshal(config.RequestBody)
-		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %v", err)
-		}
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, config.Method, config.URL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	if config.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+config.Token)
-	}
-	for key, value := range config.Headers {
-		req.Header.Set(key, value)
-	}
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
+// PromptForSecret asks for a secret on stderr without echoing it, so that the
+// prompt stays out of the command output.
+func PromptForSecret(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	secretBytes, err := term.ReadPassword(int(syscall.Stdin))
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %v", err)
+		return "", fmt.Errorf("failed to read input: %v", err)
 	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("request failed with status %s", string(bodyBytes))
-	}
-
-	if config.Response != nil {
-		if err := json.Unmarshal(bodyBytes, config.Response); err != nil {
-			return fmt.Errorf("failed to decode response: %v", err)
-		}
-	}
-
-	bar.SetCurrent(100)
-	bar.Finish()
-	return nil
+	fmt.Fprintln(os.Stderr)
+	return string(secretBytes), nil
 }
 
-func PromptForPassword() (string, error) {
-	fmt.Print("Enter password: ")
-	passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
-	if err != nil {
-		return "", fmt.Errorf("failed to read password: %v", err)
+// Confirm asks a yes/no question on stderr and reports whether it was
+// answered with y or yes. It fails when stdin is not a terminal.
+func Confirm(question string) (bool, error) {
+	if !term.IsTerminal(int(syscall.Stdin)) {
+		return false, errors.New("cannot ask for confirmation, stdin is not a terminal")
 	}
-	fmt.Println()
-	return string(passwordBytes), nil
-}
 
-func ReadTokenFromFile() (string, error) {
-	token, err := ioutil.ReadFile(tokenFilePath)
-	if err != nil {
-		return "", fmt.Errorf("failed to read token file: %w", err)
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read answer: %v", err)
 	}
-	return string(token), nil
-}
 
-func SaveTokenToFile(token string) error {
-	tokenFilePath := tokenFilePath
-	return ioutil.WriteFile(tokenFilePath, []byte(token), 0600)
-}
-
-func SaveYAMLOrJSONResponseToFile(response interface{}, filePath string) error {
-	if strings.HasSuffix(filePath, ".json") {
-		jsonData, err := json.MarshalIndent(response, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to convert to JSON: %v", err)
-		}
-		err = ioutil.WriteFile(filePath, jsonData, 0644)
-		if err != nil {
-			return fmt.Errorf("failed to write JSON file: %v", err)
-		}
-		fmt.Printf("Response saved to %s\n", filePath)
-	} else if strings.HasSuffix(filePath, ".yaml") {
-		yamlData, err := yaml.Marshal(response)
-		if err != nil {
-			return fmt.Errorf("failed to convert to YAML: %v", err)
-		}
-		err = ioutil.WriteFile(filePath, yamlData, 0644)
-		if err != nil {
-			return fmt.Errorf("failed to write YAML file: %v", err)
-		}
-		fmt.Printf("Response saved to %s\n", filePath)
-	} else {
-		return fmt.Errorf("unsupported file extension")
-	}
-	return nil
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
 }
 
 func ReadYAML(filePath string, out interface{}) error {
@@ -206,12 +79,18 @@ func ReadJSON(filePath string, out interface{}) error {
 }
 
 func PrepareRequestBodyFromYAMLOrJSON(path string) (map[string]interface{}, error) {
-	var configData map[string]interface{}
-
 	fileContent, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %v", err)
 	}
+	return parseYAMLOrJSON(path, fileContent)
+}
+
+// parseYAMLOrJSON parses the content of the file at path as YAML or JSON,
+// following its extension.
+func parseYAMLOrJSON(path string, fileContent []byte) (map[string]interface{}, error) {
+	var configData map[string]interface{}
+	var err error
 
 	if strings.HasSuffix(path, ".yaml") {
 		err = yaml.Unmarshal(fileContent, &configData)
@@ -266,6 +145,20 @@ func StringToFloat(s string) float64 {
 	return value
 }
 
+// ParseHeaders turns repeated key=value flag values into a header map.
+func ParseHeaders(values []string) (map[string]string, error) {
+	headers := make(map[string]string, len(values))
+	for _, value := range values {
+		key, headerValue, ok := strings.Cut(value, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid header %q, expected key=value", value)
+		}
+		headers[key] = strings.TrimSpace(headerValue)
+	}
+	return headers, nil
+}
+
 func ValidateRequiredFlags(cmd *cobra.Command, requiredFlags []string) error {
 	for _, flag := range requiredFlags {
 		value, err := cmd.Flags().GetString(flag)
@@ -278,3 +171,15 @@ func ValidateRequiredFlags(cmd *cobra.Command, requiredFlags []string) error {
 	}
 	return nil
 }
+
+// DocumentName joins the given string fields of a request document with
+// slashes, skipping missing ones, e.g. "c12s/default/app" for an app.
+func DocumentName(document map[string]interface{}, keys ...string) string {
+	var parts []string
+	for _, key := range keys {
+		if value, ok := document[key].(string); ok && value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, "/")
+}
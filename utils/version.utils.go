@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version parts --bump increments.
+const (
+	BumpMajor = "major"
+	BumpMinor = "minor"
+	BumpPatch = "patch"
+)
+
+var BumpParts = []string{BumpMajor, BumpMinor, BumpPatch}
+
+// Semver is a parsed semantic version such as v1.2.3. Versions keep their
+// "v" prefix when formatted if they were parsed with one.
+type Semver struct {
+	Major, Minor, Patch int
+	Prerelease          string
+	Prefixed            bool
+}
+
+// ParseSemver parses major.minor.patch versions with an optional "v" prefix
+// and pre-release suffix. Build metadata is ignored, and missing minor and
+// patch numbers are zero, so that v3 is read as v3.0.0.
+func ParseSemver(version string) (Semver, bool) {
+	var semver Semver
+	rest := version
+	if strings.HasPrefix(rest, "v") {
+		semver.Prefixed = true
+		rest = rest[1:]
+	}
+	rest, _, _ = strings.Cut(rest, "+")
+	rest, semver.Prerelease, _ = strings.Cut(rest, "-")
+
+	parts := strings.Split(rest, ".")
+	if len(parts) > 3 {
+		return Semver{}, false
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || part == "" || (len(part) > 1 && part[0] == '0') {
+			return Semver{}, false
+		}
+		numbers[i] = n
+	}
+	semver.Major, semver.Minor, semver.Patch = numbers[0], numbers[1], numbers[2]
+	return semver, true
+}
+
+func (s Semver) String() string {
+	version := strconv.Itoa(s.Major) + "." + strconv.Itoa(s.Minor) + "." + strconv.Itoa(s.Patch)
+	if s.Prerelease != "" {
+		version += "-" + s.Prerelease
+	}
+	if s.Prefixed {
+		version = "v" + version
+	}
+	return version
+}
+
+// Bump increments the version at part, resetting the parts after it and
+// dropping any pre-release.
+func (s Semver) Bump(part string) Semver {
+	next := Semver{Major: s.Major, Minor: s.Minor, Patch: s.Patch, Prefixed: s.Prefixed}
+	switch part {
+	case BumpMajor:
+		next.Major, next.Minor, next.Patch = s.Major+1, 0, 0
+	case BumpMinor:
+		next.Minor, next.Patch = s.Minor+1, 0
+	case BumpPatch:
+		next.Patch = s.Patch + 1
+	}
+	return next
+}
+
+// Compare orders semantic versions, with pre-releases before their release.
+// Pre-releases are ordered by their dot-separated identifiers as in the
+// SemVer spec, so that rc.2 comes before rc.10.
+func (s Semver) Compare(other Semver) int {
+	for _, diff := range []int{s.Major - other.Major, s.Minor - other.Minor, s.Patch - other.Patch} {
+		if diff != 0 {
+			return sign(diff)
+		}
+	}
+	switch {
+	case s.Prerelease == other.Prerelease:
+		return 0
+	case s.Prerelease == "":
+		return 1
+	case other.Prerelease == "":
+		return -1
+	}
+	return comparePrerelease(s.Prerelease, other.Prerelease)
+}
+
+// comparePrerelease compares pre-release identifiers one by one: numeric
+// identifiers numerically and before alphanumeric ones, which are compared
+// as text. When all identifiers are equal, the longer pre-release is greater.
+func comparePrerelease(a, b string) int {
+	identifiersA := strings.Split(a, ".")
+	identifiersB := strings.Split(b, ".")
+	for i := 0; i < len(identifiersA) && i < len(identifiersB); i++ {
+		numberA, errA := strconv.Atoi(identifiersA[i])
+		numberB, errB := strconv.Atoi(identifiersB[i])
+		switch {
+		case errA == nil && errB == nil:
+			if numberA != numberB {
+				return sign(numberA - numberB)
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			if order := strings.Compare(identifiersA[i], identifiersB[i]); order != 0 {
+				return order
+			}
+		}
+	}
+	return sign(len(identifiersA) - len(identifiersB))
+}
+
+// CompareVersions orders config versions: semantic versions by precedence,
+// so that v2 comes before v10, and after any other versions, which are
+// ordered as text.
+func CompareVersions(a, b string) int {
+	semverA, okA := ParseSemver(a)
+	semverB, okB := ParseSemver(b)
+	switch {
+	case okA && okB:
+		return semverA.Compare(semverB)
+	case okA:
+		return 1
+	case okB:
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+// ValidateBump checks a --bump value.
+func ValidateBump(part string) error {
+	for _, valid := range BumpParts {
+		if part == valid {
+			return nil
+		}
+	}
+	return &ValidationError{Message: fmt.Sprintf("invalid --bump %q, expected one of: %s", part, strings.Join(BumpParts, ", "))}
+}
+
+// NextVersion bumps at part the greater of current and the greatest semantic
+// version in existing. When existing has none, current is the first version
+// if it is a semantic version; otherwise v0.0.0 is bumped.
+func NextVersion(existing []string, current, part string) string {
+	var latest Semver
+	found := false
+	for _, version := range existing {
+		semver, ok := ParseSemver(version)
+		if ok && (!found || semver.Compare(latest) > 0) {
+			latest, found = semver, true
+		}
+	}
+
+	currentSemver, currentOk := ParseSemver(current)
+	switch {
+	case !found && currentOk:
+		return current
+	case !found:
+		return Semver{Prefixed: true}.Bump(part).String()
+	case currentOk && currentSemver.Compare(latest) > 0:
+		latest = currentSemver
+	}
+	return latest.Bump(part).String()
+}
@@ -0,0 +1,32 @@
+package utils
+
+import "testing"
+
+func TestNextVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []string
+		current  string
+		part     string
+		want     string
+	}{
+		{"after the greatest stored version", []string{"v1.2.4", "v1.0.0"}, "v1.0.0", BumpMinor, "v1.3.0"},
+		{"file version greater than stored", []string{"v1.0.0"}, "v2.0.0", BumpPatch, "v2.0.1"},
+		{"file version equal to stored", []string{"v2.0.0"}, "v2.0.0", BumpPatch, "v2.0.1"},
+		{"file version below stored", []string{"v3.1.0"}, "v2.0.0", BumpMajor, "v4.0.0"},
+		{"file version not semantic", []string{"v1.0.0"}, "latest", BumpPatch, "v1.0.1"},
+		{"versions ordered numerically", []string{"v2", "v10"}, "", BumpMinor, "v10.1.0"},
+		{"pre-releases ordered numerically", []string{"v1.0.0-rc.2", "v1.0.0-rc.10"}, "v1.0.0-rc.1", BumpPatch, "v1.0.1"},
+		{"no stored versions keeps the file version", nil, "v1.0.0", BumpPatch, "v1.0.0"},
+		{"no stored versions without a file version", nil, "", BumpMinor, "v0.1.0"},
+		{"only non-semantic stored versions", []string{"latest"}, "draft", BumpMajor, "v1.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NextVersion(test.existing, test.current, test.part); got != test.want {
+				t.Errorf("NextVersion(%v, %q, %q) = %q, want %q", test.existing, test.current, test.part, got, test.want)
+			}
+		})
+	}
+}